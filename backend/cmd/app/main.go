@@ -1,13 +1,22 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
+	"net/http"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/robitooS/backend/internal/config"
+	"github.com/robitooS/backend/internal/entity"
+	"github.com/robitooS/backend/internal/foto"
 	"github.com/robitooS/backend/internal/handler"
 	"github.com/robitooS/backend/internal/infra/database"
+	"github.com/robitooS/backend/internal/logger"
 	"github.com/robitooS/backend/internal/repository"
 	"github.com/robitooS/backend/internal/service"
 )
@@ -29,10 +38,55 @@ func main() {
 		log.Fatalf("Erro ao executar as migrations: %v", err);
 	}
 
+	// Inicializa o log de exclusões no destino configurado
+	delSink, err := logger.NewSink(cfg)
+	if err != nil {
+		log.Fatalf("Erro ao iniciar o log de exclusões: %v", err)
+	}
+	delLogger := logger.NewDeletionLogger(delSink)
+	defer delLogger.Close()
+
+	fotoStorage, err := foto.NewArmazenamentoLocal(cfg.FotoDir)
+	if err != nil {
+		log.Fatalf("Erro ao iniciar o armazenamento de fotos: %v", err)
+	}
+
 	// Inicializa o repositório, serviço e handler
 	contatoRepo := repository.NewContatoPostgres(db)
-	contatoService := service.NewContatoService(contatoRepo)
-	contatoHandler := handler.NewContatoHandler(contatoService, cfg.DelLogPath)
+	contatoService := service.NewContatoService(contatoRepo, fotoStorage)
+	contatoHandler := handler.NewContatoHandler(contatoService, delLogger)
+
+	auditoriaRepo := repository.NewAuditoriaPostgres(db)
+	auditoriaService := service.NewAuditoriaService(auditoriaRepo)
+	auditoriaHandler := handler.NewAuditoriaHandler(auditoriaService)
+
+	grupoRepo := repository.NewGrupoPostgres(db)
+	grupoService := service.NewGrupoService(grupoRepo)
+	grupoHandler := handler.NewGrupoHandler(grupoService)
+
+	usuarioRepo := repository.NewUsuarioPostgres(db)
+	authService := service.NewAuthService(usuarioRepo, cfg.JWTSegredo, cfg.JWTExpiracao)
+	authHandler := handler.NewAuthHandler(authService)
+	usuarioHandler := handler.NewUsuarioHandler(service.NewUsuarioService(usuarioRepo))
+
+	if cfg.AdminLogin != "" && cfg.AdminSenha == "" {
+		log.Printf("AVISO: ADMIN_SENHA não definida, o usuário inicial %q não será criado", cfg.AdminLogin)
+	} else if cfg.AdminLogin != "" {
+		inicial := &entity.Usuario{Login: cfg.AdminLogin, Senha: cfg.AdminSenha}
+		if err := authService.CriarUsuarioInicial(context.Background(), inicial); err != nil {
+			log.Fatalf("Erro ao criar o usuário inicial: %v", err)
+		}
+	}
+
+	agendaService := service.NewAgendaService(repository.NewAgendaPostgres(db))
+	agendaHandler := handler.NewAgendaHandler(agendaService)
+
+	// Contatos migrados antes de existir um administrador ficam em uma agenda sem dono
+	if adotadas, err := agendaService.AdotarAgendasSemDono(context.Background()); err != nil {
+		log.Fatalf("Erro ao atribuir dono às agendas: %v", err)
+	} else if adotadas > 0 {
+		log.Printf("%d agenda(s) sem dono atribuída(s) ao administrador", adotadas)
+	}
 
 	// Configura o roteador Gin
 	router := gin.Default()
@@ -41,8 +95,9 @@ func main() {
 	router.Use(func(c *gin.Context) {
 		c.Writer.Header().Set("Access-Control-Allow-Origin", "*")
 		c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
-		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With")
-		c.Writer.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS, GET, PUT, DELETE")
+		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With, If-Match")
+		c.Writer.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS, GET, PUT, PATCH, DELETE")
+		c.Writer.Header().Set("Access-Control-Expose-Headers", "ETag, X-Next-Cursor, X-Total-Count")
 
 		if c.Request.Method == "OPTIONS" {
 			c.AbortWithStatus(204)
@@ -52,11 +107,39 @@ func main() {
 		c.Next()
 	})
 
-	contatoHandler.RegisterRoutes(router)
+	router.Use(handler.IdentificarAutor())
+
+	authHandler.RegisterRoutes(router)
+
+	// Todas as demais rotas exigem um token obtido em /auth/login
+	protegido := router.Group("", handler.Autenticar(authService))
+	usuarioHandler.RegisterRoutes(protegido)
+	agendaHandler.RegisterRoutes(protegido)
+
+	// Os contatos pertencem a uma agenda e só são acessíveis pelo dono e por quem a recebeu compartilhada
+	naAgenda := protegido.Group("/agendas/:agendaId", handler.AcessarAgenda(agendaService))
+	agendaHandler.RegisterAgendaRoutes(naAgenda)
+	contatoHandler.RegisterRoutes(naAgenda)
+	grupoHandler.RegisterAgendaRoutes(naAgenda)
+	auditoriaHandler.RegisterRoutes(naAgenda)
+
+	// Inicia o servidor e aguarda um sinal de término para encerrar sem perder o log de exclusões
+	srv := &http.Server{Addr: fmt.Sprintf(":%s", cfg.API_PORT), Handler: router}
+	go func() {
+		log.Printf("Servidor iniciando na porta %s", cfg.API_PORT)
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("Erro ao iniciar o servidor: %v", err)
+		}
+	}()
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	<-ctx.Done()
 
-	// Inicia o servidor
-	log.Printf("Servidor iniciando na porta %s", cfg.API_PORT)
-	if err := router.Run(fmt.Sprintf(":%s", cfg.API_PORT)); err != nil {
-		log.Fatalf("Erro ao iniciar o servidor: %v", err)
+	log.Printf("Encerrando o servidor")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		log.Printf("Erro ao encerrar o servidor: %v", err)
 	}
 }
@@ -1,20 +1,37 @@
 package config
 
 import (
-	"github.com/joho/godotenv"
+	"fmt"
 	"log"
 	"os"
+	"strconv"
+	"time"
+
+	"github.com/joho/godotenv"
 )
 
 type Config struct {
-	DB_USER   string
-	DB_PASS   string
-	DB_HOST   string
-	DB_PORT   string
-	DB_NAME   string
-	DB_SOURCE string
-	API_PORT  string
-	DelLogPath string // Caminho para o log de deleções (arquivo .txt)
+	DB_USER    string
+	DB_PASS    string
+	DB_HOST    string
+	DB_PORT    string
+	DB_NAME    string
+	DB_SOURCE  string
+	API_PORT   string
+	DelLogSink string // Destino do log de deleções: arquivo, stdout ou syslog
+	DelLogPath string // Caminho para o log de deleções (JSON lines)
+
+	DelLogMaxBytes  int64         // Tamanho máximo do arquivo antes da rotação (0 desativa)
+	DelLogMaxAge    time.Duration // Idade máxima do arquivo antes da rotação (0 desativa)
+	DelLogBuffer    int           // Registros mantidos em memória antes da escrita
+	DelLogSyslogTag string
+
+	FotoDir string // Diretório onde as fotos dos contatos são armazenadas
+
+	JWTSegredo   string        // Chave HMAC usada para assinar os tokens
+	JWTExpiracao time.Duration // Validade dos tokens emitidos no login
+	AdminLogin   string        // Usuário criado na primeira inicialização, com a tabela vazia
+	AdminSenha   string
 }
 
 func LoadConfig() (*Config, error) {
@@ -31,25 +48,92 @@ func LoadConfig() (*Config, error) {
 	dbPort := os.Getenv("DB_PORT")
 	dbName := os.Getenv("DB_NAME")
 	apiPort := os.Getenv("API_PORT")
+	delLogSink := os.Getenv("DEL_LOG_SINK")
 	delLogPath := os.Getenv("DEL_LOG_PATH")
+	delLogSyslogTag := os.Getenv("DEL_LOG_SYSLOG_TAG")
+	fotoDir := os.Getenv("FOTO_DIR")
+	jwtSegredo := os.Getenv("JWT_SEGREDO")
+	adminLogin := os.Getenv("ADMIN_LOGIN")
+	adminSenha := os.Getenv("ADMIN_SENHA")
 
 	dbSource := "postgresql://" + dbUser + ":" + dbPass + "@" + dbHost + ":" + dbPort + "/" + dbName + "?sslmode=disable"
 
 	if apiPort == "" {
 		apiPort = "8080"
 	}
+	if delLogSink == "" {
+		delLogSink = "arquivo"
+	}
 	if delLogPath == "" {
-		delLogPath = "logs/deleted_contacts.txt" 
+		delLogPath = "logs/exclusao.log"
+	}
+	if delLogSyslogTag == "" {
+		delLogSyslogTag = "agenda"
+	}
+	if fotoDir == "" {
+		fotoDir = "fotos"
+	}
+
+	if len(jwtSegredo) < 32 {
+		return nil, fmt.Errorf("config: JWT_SEGREDO deve ter no minimo 32 caracteres")
+	}
+	jwtExpiracao := 12 * time.Hour
+	if valor := os.Getenv("JWT_EXPIRACAO"); valor != "" {
+		jwtExpiracao, err = time.ParseDuration(valor)
+		if err != nil || jwtExpiracao <= 0 {
+			return nil, fmt.Errorf("config: JWT_EXPIRACAO invalido %q", valor)
+		}
+	}
+
+	delLogMaxMB, err := inteiroDoAmbiente("DEL_LOG_MAX_MB", 10)
+	if err != nil {
+		return nil, err
+	}
+	delLogBuffer, err := inteiroDoAmbiente("DEL_LOG_BUFFER", 256)
+	if err != nil {
+		return nil, err
+	}
+	delLogMaxAge := 24 * time.Hour
+	if valor := os.Getenv("DEL_LOG_MAX_AGE"); valor != "" {
+		delLogMaxAge, err = time.ParseDuration(valor)
+		if err != nil {
+			return nil, fmt.Errorf("config: DEL_LOG_MAX_AGE invalido %q: %w", valor, err)
+		}
 	}
 
 	return &Config{
-		DB_USER:   dbUser,
-		DB_PASS:   dbPass,
-		DB_HOST:   dbHost,
-		DB_PORT:   dbPort,
-		DB_NAME:   dbName,
-		DB_SOURCE: dbSource,
-		API_PORT:  apiPort,
+		DB_USER:    dbUser,
+		DB_PASS:    dbPass,
+		DB_HOST:    dbHost,
+		DB_PORT:    dbPort,
+		DB_NAME:    dbName,
+		DB_SOURCE:  dbSource,
+		API_PORT:   apiPort,
+		DelLogSink: delLogSink,
 		DelLogPath: delLogPath,
+
+		DelLogMaxBytes:  int64(delLogMaxMB) * 1024 * 1024,
+		DelLogMaxAge:    delLogMaxAge,
+		DelLogBuffer:    delLogBuffer,
+		DelLogSyslogTag: delLogSyslogTag,
+
+		FotoDir: fotoDir,
+
+		JWTSegredo:   jwtSegredo,
+		JWTExpiracao: jwtExpiracao,
+		AdminLogin:   adminLogin,
+		AdminSenha:   adminSenha,
 	}, nil
 }
+
+func inteiroDoAmbiente(nome string, padrao int) (int, error) {
+	valor := os.Getenv(nome)
+	if valor == "" {
+		return padrao, nil
+	}
+	numero, err := strconv.Atoi(valor)
+	if err != nil || numero < 0 {
+		return 0, fmt.Errorf("config: %s deve ser um inteiro nao negativo, recebido %q", nome, valor)
+	}
+	return numero, nil
+}
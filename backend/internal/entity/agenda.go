@@ -0,0 +1,31 @@
+package entity
+
+import "time"
+
+type PermissaoAgenda string
+
+const (
+	PermissaoLeitura PermissaoAgenda = "leitura"
+	PermissaoEscrita PermissaoAgenda = "escrita"
+	PermissaoDono    PermissaoAgenda = "dono"
+)
+
+func (p PermissaoAgenda) PermiteEscrita() bool {
+	return p == PermissaoEscrita || p == PermissaoDono
+}
+
+type Agenda struct {
+	ID       int64     `json:"id"`
+	Nome     string    `json:"nome"`
+	IDDono   int64     `json:"id_dono"`
+	CriadaEm time.Time `json:"criada_em"`
+	// Permissao do usuario da requisicao sobre a agenda
+	Permissao PermissaoAgenda `json:"permissao,omitempty"`
+}
+
+type Compartilhamento struct {
+	IDAgenda  int64           `json:"id_agenda"`
+	IDUsuario int64           `json:"id_usuario"`
+	Login     string          `json:"login"`
+	Permissao PermissaoAgenda `json:"permissao"`
+}
@@ -0,0 +1,8 @@
+package entity
+
+type Aniversariante struct {
+	Contato *Contato `json:"contato"`
+	Data    Data     `json:"data"`
+	Dias    int      `json:"dias"`
+	Idade   int      `json:"idade"`
+}
@@ -0,0 +1,39 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type OperacaoAuditoria string
+
+const (
+	OperacaoCriacao     OperacaoAuditoria = "criacao"
+	OperacaoAtualizacao OperacaoAuditoria = "atualizacao"
+	OperacaoExclusao    OperacaoAuditoria = "exclusao"
+	OperacaoRestauracao OperacaoAuditoria = "restauracao"
+	OperacaoExpurgo     OperacaoAuditoria = "expurgo"
+)
+
+type Auditoria struct {
+	ID        int64             `json:"id"`
+	Data      time.Time         `json:"data"`
+	Usuario   string            `json:"usuario"`
+	Operacao  OperacaoAuditoria `json:"operacao"`
+	IDContato int64             `json:"id_contato"`
+	Antes     json.RawMessage   `json:"antes"`
+	Depois    json.RawMessage   `json:"depois"`
+}
+
+type FiltroAuditoria struct {
+	IDContato int64
+	Operacao  OperacaoAuditoria
+	De        *time.Time
+	Ate       *time.Time
+}
+
+type PaginaAuditoria struct {
+	Registros     []*Auditoria
+	ProximoCursor string
+	Total         *int64
+}
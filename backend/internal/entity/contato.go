@@ -1,8 +1,17 @@
 package entity
 
+import "time"
+
 type Contato struct {
-	ID        int64      `json:"id"`
-	Nome      string     `json:"nome"`
-	Idade     int        `json:"idade"`
-	Telefones []Telefone `json:"telefones,omitempty"`
+	ID             int64      `json:"id"`
+	IDAgenda       int64      `json:"id_agenda"`
+	Nome           string     `json:"nome"`
+	Idade          int        `json:"idade"`
+	DataNascimento *Data      `json:"data_nascimento,omitempty"`
+	Telefones      []Telefone `json:"telefones,omitempty"`
+	Emails         []Email    `json:"emails,omitempty"`
+	Enderecos      []Endereco `json:"enderecos,omitempty"`
+	Relevancia     float64    `json:"relevancia,omitempty"`
+	Versao         int64      `json:"versao"`
+	DeletedAt      *time.Time `json:"deleted_at,omitempty"`
 }
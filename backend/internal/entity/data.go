@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const FormatoData = "2006-01-02"
+
+type Data struct {
+	time.Time
+}
+
+func NovaData(t time.Time) Data {
+	ano, mes, dia := t.Date()
+	return Data{time.Date(ano, mes, dia, 0, 0, 0, 0, time.UTC)}
+}
+
+func (d Data) String() string {
+	return d.Format(FormatoData)
+}
+
+func (d Data) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+func (d *Data) UnmarshalJSON(dados []byte) error {
+	var texto string
+	if err := json.Unmarshal(dados, &texto); err != nil {
+		return err
+	}
+	t, err := time.Parse(FormatoData, texto)
+	if err != nil {
+		return err
+	}
+	d.Time = t
+	return nil
+}
+
+// IdadeEm considera que quem nasceu em 29/02 faz aniversario em 01/03 nos anos nao bissextos.
+func IdadeEm(nascimento Data, referencia time.Time) int {
+	idade := referencia.Year() - nascimento.Year()
+	if referencia.Month() < nascimento.Month() || (referencia.Month() == nascimento.Month() && referencia.Day() < nascimento.Day()) {
+		idade--
+	}
+	return idade
+}
@@ -0,0 +1,21 @@
+package entity
+
+type ParDuplicado struct {
+	IDA           int64
+	IDB           int64
+	MesmoTelefone bool
+	Similaridade  float64
+}
+
+type MotivoDuplicidade string
+
+const (
+	MotivoTelefone MotivoDuplicidade = "telefone"
+	MotivoNome     MotivoDuplicidade = "nome"
+)
+
+type GrupoDuplicados struct {
+	Confianca float64             `json:"confianca"`
+	Motivos   []MotivoDuplicidade `json:"motivos"`
+	Contatos  []*Contato          `json:"contatos"`
+}
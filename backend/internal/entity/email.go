@@ -0,0 +1,7 @@
+package entity
+
+type Email struct {
+	IDContato int64  `json:"id_contato"`
+	ID        int64  `json:"id"`
+	Endereco  string `json:"endereco"`
+}
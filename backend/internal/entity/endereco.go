@@ -0,0 +1,13 @@
+package entity
+
+type Endereco struct {
+	IDContato   int64  `json:"id_contato"`
+	ID          int64  `json:"id"`
+	Logradouro  string `json:"logradouro"`
+	Numero      string `json:"numero"`
+	Complemento string `json:"complemento"`
+	Bairro      string `json:"bairro"`
+	Cidade      string `json:"cidade"`
+	UF          string `json:"uf"`
+	CEP         string `json:"cep"`
+}
@@ -0,0 +1,29 @@
+package entity
+
+type ModoBusca string
+
+const (
+	BuscaContem     ModoBusca = "contem"
+	BuscaAproximada ModoBusca = "aproximada"
+)
+
+type Ordenacao string
+
+const (
+	OrdenarPorID         Ordenacao = "id"
+	OrdenarPorRelevancia Ordenacao = "relevancia"
+)
+
+type FiltroContato struct {
+	Nome   string
+	Numero string
+	Tipo   TipoTelefone
+	Email  string
+	Cidade string
+	UF     string
+	Busca  ModoBusca
+	Ordem  Ordenacao
+	Grupo  int64
+
+	Excluidos bool
+}
@@ -0,0 +1,9 @@
+package entity
+
+type Grupo struct {
+	ID            int64  `json:"id"`
+	IDAgenda      int64  `json:"id_agenda"`
+	Nome          string `json:"nome"`
+	Descricao     string `json:"descricao"`
+	TotalContatos int64  `json:"total_contatos"`
+}
@@ -0,0 +1,13 @@
+package entity
+
+type Paginacao struct {
+	Limite       int
+	Cursor       string
+	IncluirTotal bool
+}
+
+type PaginaContatos struct {
+	Contatos      []*Contato
+	ProximoCursor string
+	Total         *int64
+}
@@ -0,0 +1,11 @@
+package entity
+
+import "time"
+
+type Revisao struct {
+	IDContato int64     `json:"id_contato"`
+	Revisao   int64     `json:"revisao"`
+	Data      time.Time `json:"data"`
+	Usuario   string    `json:"usuario"`
+	Contato   *Contato  `json:"contato"`
+}
@@ -1,7 +1,27 @@
 package entity
 
+type TipoTelefone string
+
+const (
+	TelefoneCelular     TipoTelefone = "celular"
+	TelefoneResidencial TipoTelefone = "residencial"
+	TelefoneComercial   TipoTelefone = "comercial"
+	TelefoneFax         TipoTelefone = "fax"
+	TelefoneOutro       TipoTelefone = "outro"
+)
+
 type Telefone struct {
-	IDContato int64  `json:"id_contato"`
-	ID        int64  `json:"id"`
-	Numero    string `json:"numero"`
+	IDContato  int64        `json:"id_contato"`
+	ID         int64        `json:"id"`
+	Numero     string       `json:"numero"`
+	NumeroE164 string       `json:"numero_e164"`
+	Tipo       TipoTelefone `json:"tipo"`
+	Rotulo     string       `json:"rotulo"`
+	Principal  bool         `json:"principal"`
+}
+
+type AlteracoesTelefones struct {
+	Inseridos   []int64 `json:"inseridos,omitempty"`
+	Atualizados []int64 `json:"atualizados,omitempty"`
+	Removidos   []int64 `json:"removidos,omitempty"`
 }
@@ -0,0 +1,44 @@
+package entity
+
+import "time"
+
+type Papel string
+
+const (
+	PapelLeitor Papel = "leitor"
+	PapelEditor Papel = "editor"
+	PapelAdmin  Papel = "admin"
+)
+
+var niveisPapel = map[Papel]int{PapelLeitor: 1, PapelEditor: 2, PapelAdmin: 3}
+
+func (p Papel) Valido() bool {
+	_, ok := niveisPapel[p]
+	return ok
+}
+
+// Permite informa se o papel inclui as permissoes de minimo; cada papel herda as do anterior.
+func (p Papel) Permite(minimo Papel) bool {
+	return p.Valido() && niveisPapel[p] >= niveisPapel[minimo]
+}
+
+type Usuario struct {
+	ID        int64     `json:"id"`
+	Login     string    `json:"login"`
+	Nome      string    `json:"nome"`
+	Papel     Papel     `json:"papel"`
+	Senha     string    `json:"senha,omitempty"`
+	SenhaHash string    `json:"-"`
+	CriadoEm  time.Time `json:"criado_em"`
+}
+
+type Credenciais struct {
+	Login string `json:"login"`
+	Senha string `json:"senha"`
+}
+
+type Sessao struct {
+	Token    string    `json:"token"`
+	ExpiraEm time.Time `json:"expira_em"`
+	Usuario  *Usuario  `json:"usuario"`
+}
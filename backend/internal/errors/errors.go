@@ -6,12 +6,13 @@ import (
 )
 
 var (
-	ErrNotFound      = errors.New("not found")
-	ErrInvalidInput  = errors.New("invalid input")
-	ErrAlreadyExists = errors.New("already exists")
-	ErrUnauthorized  = errors.New("unauthorized")
-	ErrForbidden     = errors.New("forbidden")
-	ErrInternal      = errors.New("internal server error")
+	ErrNotFound        = errors.New("not found")
+	ErrInvalidInput    = errors.New("invalid input")
+	ErrAlreadyExists   = errors.New("already exists")
+	ErrVersionConflict = errors.New("version conflict")
+	ErrUnauthorized    = errors.New("unauthorized")
+	ErrForbidden       = errors.New("forbidden")
+	ErrInternal        = errors.New("internal server error")
 )
 
 type APIError struct {
@@ -0,0 +1,110 @@
+package foto
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/robitooS/backend/internal/errors"
+	"golang.org/x/image/draw"
+)
+
+const (
+	TamanhoMaximo   = 5 << 20
+	DimensaoMaxima  = 6000
+	LadoMiniatura   = 256
+	qualidadeJPEG   = 85
+	ContentTypeJPEG = "image/jpeg"
+	ContentTypePNG  = "image/png"
+)
+
+type Variante string
+
+const (
+	VarianteOriginal  Variante = "original"
+	VarianteMiniatura Variante = "miniatura"
+)
+
+type Imagem struct {
+	ContentType string
+	Original    []byte
+	Miniatura   []byte
+}
+
+type Arquivo struct {
+	Conteudo     io.ReadSeekCloser
+	ContentType  string
+	Tamanho      int64
+	ModificadoEm time.Time
+}
+
+type FotoStorage interface {
+	Salvar(ctx context.Context, idContato int64, imagem *Imagem) error
+	Abrir(ctx context.Context, idContato int64, variante Variante) (*Arquivo, error)
+	Remover(ctx context.Context, idContato int64) error
+}
+
+func Processar(dados []byte) (*Imagem, error) {
+	if len(dados) == 0 {
+		return nil, errors.WrapErrorf(errors.ErrInvalidInput, "foto: arquivo vazio")
+	}
+	if len(dados) > TamanhoMaximo {
+		return nil, errors.WrapErrorf(errors.ErrInvalidInput, "foto: arquivo excede %d MB", TamanhoMaximo>>20)
+	}
+
+	contentType := http.DetectContentType(dados)
+	if contentType != ContentTypeJPEG && contentType != ContentTypePNG {
+		return nil, errors.WrapErrorf(errors.ErrInvalidInput, "foto: tipo %q nao suportado, envie JPEG ou PNG", contentType)
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(dados))
+	if err != nil {
+		return nil, errors.WrapErrorf(errors.ErrInvalidInput, "foto: imagem invalida: %v", err)
+	}
+	if config.Width > DimensaoMaxima || config.Height > DimensaoMaxima {
+		return nil, errors.WrapErrorf(errors.ErrInvalidInput, "foto: dimensoes %dx%d excedem %dx%d", config.Width, config.Height, DimensaoMaxima, DimensaoMaxima)
+	}
+
+	original, _, err := image.Decode(bytes.NewReader(dados))
+	if err != nil {
+		return nil, errors.WrapErrorf(errors.ErrInvalidInput, "foto: imagem invalida: %v", err)
+	}
+
+	miniatura, err := gerarMiniatura(original, contentType)
+	if err != nil {
+		return nil, err
+	}
+	return &Imagem{ContentType: contentType, Original: dados, Miniatura: miniatura}, nil
+}
+
+func gerarMiniatura(original image.Image, contentType string) ([]byte, error) {
+	limites := original.Bounds()
+	largura, altura := limites.Dx(), limites.Dy()
+	if largura > LadoMiniatura || altura > LadoMiniatura {
+		if largura >= altura {
+			largura, altura = LadoMiniatura, max(1, altura*LadoMiniatura/largura)
+		} else {
+			largura, altura = max(1, largura*LadoMiniatura/altura), LadoMiniatura
+		}
+	}
+
+	destino := image.NewRGBA(image.Rect(0, 0, largura, altura))
+	draw.CatmullRom.Scale(destino, destino.Bounds(), original, limites, draw.Src, nil)
+
+	var buf bytes.Buffer
+	var err error
+	if contentType == ContentTypePNG {
+		err = png.Encode(&buf, destino)
+	} else {
+		err = jpeg.Encode(&buf, destino, &jpeg.Options{Quality: qualidadeJPEG})
+	}
+	if err != nil {
+		return nil, errors.WrapErrorf(err, "foto: falha ao gerar miniatura")
+	}
+	return buf.Bytes(), nil
+}
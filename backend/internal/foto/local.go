@@ -0,0 +1,101 @@
+package foto
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/robitooS/backend/internal/errors"
+)
+
+type ArmazenamentoLocal struct {
+	diretorio string
+}
+
+func NewArmazenamentoLocal(diretorio string) (*ArmazenamentoLocal, error) {
+	if err := os.MkdirAll(diretorio, 0755); err != nil {
+		return nil, fmt.Errorf("foto: falha ao criar diretorio %s: %w", diretorio, err)
+	}
+	return &ArmazenamentoLocal{diretorio: diretorio}, nil
+}
+
+var extensoes = map[string]string{
+	ContentTypeJPEG: ".jpg",
+	ContentTypePNG:  ".png",
+}
+
+func (a *ArmazenamentoLocal) Salvar(ctx context.Context, idContato int64, imagem *Imagem) error {
+	extensao, ok := extensoes[imagem.ContentType]
+	if !ok {
+		return errors.WrapErrorf(errors.ErrInvalidInput, "foto: tipo %q nao suportado", imagem.ContentType)
+	}
+
+	pasta := a.pasta(idContato)
+	if err := os.MkdirAll(pasta, 0755); err != nil {
+		return fmt.Errorf("foto: falha ao criar diretorio do contato %d: %w", idContato, err)
+	}
+
+	arquivos := map[Variante][]byte{VarianteOriginal: imagem.Original, VarianteMiniatura: imagem.Miniatura}
+	for variante, dados := range arquivos {
+		destino := filepath.Join(pasta, string(variante)+extensao)
+		if err := gravarAtomico(destino, dados); err != nil {
+			return fmt.Errorf("foto: falha ao gravar %s do contato %d: %w", variante, idContato, err)
+		}
+		for outra := range extensoes {
+			if ext := extensoes[outra]; ext != extensao {
+				os.Remove(filepath.Join(pasta, string(variante)+ext))
+			}
+		}
+	}
+	return nil
+}
+
+func (a *ArmazenamentoLocal) Abrir(ctx context.Context, idContato int64, variante Variante) (*Arquivo, error) {
+	for contentType, extensao := range extensoes {
+		caminho := filepath.Join(a.pasta(idContato), string(variante)+extensao)
+		arquivo, err := os.Open(caminho)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("foto: falha ao abrir %s: %w", caminho, err)
+		}
+		info, err := arquivo.Stat()
+		if err != nil {
+			arquivo.Close()
+			return nil, fmt.Errorf("foto: falha ao consultar %s: %w", caminho, err)
+		}
+		return &Arquivo{Conteudo: arquivo, ContentType: contentType, Tamanho: info.Size(), ModificadoEm: info.ModTime()}, nil
+	}
+	return nil, errors.WrapErrorf(errors.ErrNotFound, "foto: contato %d nao possui foto", idContato)
+}
+
+func (a *ArmazenamentoLocal) Remover(ctx context.Context, idContato int64) error {
+	if err := os.RemoveAll(a.pasta(idContato)); err != nil {
+		return fmt.Errorf("foto: falha ao remover fotos do contato %d: %w", idContato, err)
+	}
+	return nil
+}
+
+func (a *ArmazenamentoLocal) pasta(idContato int64) string {
+	return filepath.Join(a.diretorio, strconv.FormatInt(idContato, 10))
+}
+
+func gravarAtomico(destino string, dados []byte) error {
+	temporario, err := os.CreateTemp(filepath.Dir(destino), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(temporario.Name())
+
+	if _, err := temporario.Write(dados); err != nil {
+		temporario.Close()
+		return err
+	}
+	if err := temporario.Close(); err != nil {
+		return err
+	}
+	return os.Rename(temporario.Name(), destino)
+}
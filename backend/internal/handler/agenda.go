@@ -0,0 +1,203 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/robitooS/backend/internal/entity"
+	"github.com/robitooS/backend/internal/requisicao"
+	"github.com/robitooS/backend/internal/service"
+
+	errorsCustom "github.com/robitooS/backend/internal/errors"
+)
+
+type AgendaHandler struct {
+	service service.AgendaService
+}
+
+func NewAgendaHandler(s service.AgendaService) *AgendaHandler {
+	return &AgendaHandler{service: s}
+}
+
+func (h *AgendaHandler) RegisterRoutes(router gin.IRouter) {
+	router.POST("/agendas", exigirEditor, h.CreateAgenda)
+	router.GET("/agendas", h.GetAgendas)
+}
+
+// RegisterAgendaRoutes registra as rotas de uma agenda no grupo /agendas/:agendaId protegido por AcessarAgenda.
+func (h *AgendaHandler) RegisterAgendaRoutes(router gin.IRouter) {
+	router.GET("", h.GetAgenda)
+	router.PUT("", h.UpdateAgenda)
+	router.DELETE("", h.DeleteAgenda)
+	router.GET("/compartilhamentos", h.GetCompartilhamentos)
+	router.PUT("/compartilhamentos/:usuarioId", h.Compartilhar)
+	router.DELETE("/compartilhamentos/:usuarioId", h.RemoverCompartilhamento)
+}
+
+// AcessarAgenda confere se o usuario pode ler a agenda da rota, ou altera-la nos metodos de escrita,
+// e restringe a ela as consultas de contatos da requisicao.
+func AcessarAgenda(s service.AgendaService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := parametroID(c, "agendaId")
+		if err != nil {
+			responderErro(c, err)
+			c.Abort()
+			return
+		}
+
+		escrita := c.Request.Method != http.MethodGet && c.Request.Method != http.MethodHead
+		agenda, err := s.Acessar(c.Request.Context(), id, escrita)
+		if err != nil {
+			responderErro(c, err)
+			c.Abort()
+			return
+		}
+
+		c.Request = c.Request.WithContext(requisicao.ComAgenda(c.Request.Context(), agenda.ID))
+		c.Next()
+	}
+}
+
+func (h *AgendaHandler) CreateAgenda(c *gin.Context) {
+	var agenda entity.Agenda
+	if err := c.ShouldBindJSON(&agenda); err != nil {
+		responderErro(c, errorsCustom.WrapErrorf(errorsCustom.ErrInvalidInput, "entrada invalida para criacao de agenda: %v", err))
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := h.service.Create(ctx, &agenda); err != nil {
+		responderErro(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, agenda)
+}
+
+func (h *AgendaHandler) GetAgendas(c *gin.Context) {
+	ctx := c.Request.Context()
+	agendas, err := h.service.FindAll(ctx)
+	if err != nil {
+		responderErro(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, agendas)
+}
+
+func (h *AgendaHandler) GetAgenda(c *gin.Context) {
+	id, err := parametroID(c, "agendaId")
+	if err != nil {
+		responderErro(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	agenda, err := h.service.FindByID(ctx, id)
+	if err != nil {
+		responderErro(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, agenda)
+}
+
+func (h *AgendaHandler) UpdateAgenda(c *gin.Context) {
+	id, err := parametroID(c, "agendaId")
+	if err != nil {
+		responderErro(c, err)
+		return
+	}
+	var agenda entity.Agenda
+	if err := c.ShouldBindJSON(&agenda); err != nil {
+		responderErro(c, errorsCustom.WrapErrorf(errorsCustom.ErrInvalidInput, "entrada invalida para atualizacao de agenda: %v", err))
+		return
+	}
+	agenda.ID = id
+
+	ctx := c.Request.Context()
+	if err := h.service.Update(ctx, &agenda); err != nil {
+		responderErro(c, err)
+		return
+	}
+	atualizada, err := h.service.FindByID(ctx, id)
+	if err != nil {
+		responderErro(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, atualizada)
+}
+
+func (h *AgendaHandler) DeleteAgenda(c *gin.Context) {
+	id, err := parametroID(c, "agendaId")
+	if err != nil {
+		responderErro(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := h.service.Delete(ctx, id); err != nil {
+		responderErro(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *AgendaHandler) GetCompartilhamentos(c *gin.Context) {
+	id, err := parametroID(c, "agendaId")
+	if err != nil {
+		responderErro(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	compartilhamentos, err := h.service.FindCompartilhamentos(ctx, id)
+	if err != nil {
+		responderErro(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, compartilhamentos)
+}
+
+func (h *AgendaHandler) Compartilhar(c *gin.Context) {
+	idAgenda, err := parametroID(c, "agendaId")
+	if err != nil {
+		responderErro(c, err)
+		return
+	}
+	idUsuario, err := parametroID(c, "usuarioId")
+	if err != nil {
+		responderErro(c, err)
+		return
+	}
+	var compartilhamento entity.Compartilhamento
+	if err := c.ShouldBindJSON(&compartilhamento); err != nil {
+		responderErro(c, errorsCustom.WrapErrorf(errorsCustom.ErrInvalidInput, "entrada invalida para compartilhamento de agenda: %v", err))
+		return
+	}
+	compartilhamento.IDAgenda, compartilhamento.IDUsuario = idAgenda, idUsuario
+
+	ctx := c.Request.Context()
+	if err := h.service.Compartilhar(ctx, &compartilhamento); err != nil {
+		responderErro(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, compartilhamento)
+}
+
+func (h *AgendaHandler) RemoverCompartilhamento(c *gin.Context) {
+	idAgenda, err := parametroID(c, "agendaId")
+	if err != nil {
+		responderErro(c, err)
+		return
+	}
+	idUsuario, err := parametroID(c, "usuarioId")
+	if err != nil {
+		responderErro(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := h.service.RemoverCompartilhamento(ctx, idAgenda, idUsuario); err != nil {
+		responderErro(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/robitooS/backend/internal/entity"
+	"github.com/robitooS/backend/internal/requisicao"
+	"github.com/robitooS/backend/internal/service"
+
+	errorsCustom "github.com/robitooS/backend/internal/errors"
+)
+
+const formatoData = "2006-01-02"
+
+type AuditoriaHandler struct {
+	service service.AuditoriaService
+}
+
+func NewAuditoriaHandler(s service.AuditoriaService) *AuditoriaHandler {
+	return &AuditoriaHandler{service: s}
+}
+
+func (h *AuditoriaHandler) RegisterRoutes(router gin.IRouter) {
+	router.GET("/auditoria", exigirAdmin, h.GetAuditoria)
+}
+
+func IdentificarAutor() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := requisicao.ComAutor(c.Request.Context(), c.ClientIP())
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
+
+func (h *AuditoriaHandler) GetAuditoria(c *gin.Context) {
+	filtro, err := filtroAuditoriaDaQuery(c)
+	if err != nil {
+		responderErro(c, err)
+		return
+	}
+	pag, err := paginacaoDaQuery(c)
+	if err != nil {
+		responderErro(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	pagina, err := h.service.FindWithFilters(ctx, filtro, pag)
+	if err != nil {
+		responderErro(c, err)
+		return
+	}
+
+	if pagina.ProximoCursor != "" {
+		c.Header("X-Next-Cursor", pagina.ProximoCursor)
+	}
+	if pagina.Total != nil {
+		c.Header("X-Total-Count", strconv.FormatInt(*pagina.Total, 10))
+	}
+	c.JSON(http.StatusOK, pagina.Registros)
+}
+
+func filtroAuditoriaDaQuery(c *gin.Context) (entity.FiltroAuditoria, error) {
+	filtro := entity.FiltroAuditoria{Operacao: entity.OperacaoAuditoria(c.Query("operacao"))}
+
+	if contato := c.Query("contato"); contato != "" {
+		id, err := strconv.ParseInt(contato, 10, 64)
+		if err != nil {
+			return filtro, errorsCustom.WrapErrorf(errorsCustom.ErrInvalidInput, "entrada invalida para contato %q", contato)
+		}
+		filtro.IDContato = id
+	}
+
+	de, err := dataDaQuery(c, "de", false)
+	if err != nil {
+		return filtro, err
+	}
+	ate, err := dataDaQuery(c, "ate", true)
+	if err != nil {
+		return filtro, err
+	}
+	filtro.De, filtro.Ate = de, ate
+
+	return filtro, nil
+}
+
+func dataDaQuery(c *gin.Context, nome string, fimDoDia bool) (*time.Time, error) {
+	valor := c.Query(nome)
+	if valor == "" {
+		return nil, nil
+	}
+
+	if data, err := time.Parse(time.RFC3339, valor); err == nil {
+		return &data, nil
+	}
+	data, err := time.Parse(formatoData, valor)
+	if err != nil {
+		return nil, errorsCustom.WrapErrorf(errorsCustom.ErrInvalidInput, "entrada invalida para %s %q, use AAAA-MM-DD ou RFC 3339", nome, valor)
+	}
+	if fimDoDia {
+		data = data.Add(24*time.Hour - time.Nanosecond)
+	}
+	return &data, nil
+}
@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/robitooS/backend/internal/entity"
+	"github.com/robitooS/backend/internal/requisicao"
+	"github.com/robitooS/backend/internal/service"
+
+	errorsCustom "github.com/robitooS/backend/internal/errors"
+)
+
+type AuthHandler struct {
+	service service.AuthService
+}
+
+func NewAuthHandler(s service.AuthService) *AuthHandler {
+	return &AuthHandler{service: s}
+}
+
+func (h *AuthHandler) RegisterRoutes(router gin.IRouter) {
+	router.POST("/auth/login", h.Login)
+}
+
+func (h *AuthHandler) Login(c *gin.Context) {
+	var credenciais entity.Credenciais
+	if err := c.ShouldBindJSON(&credenciais); err != nil {
+		responderErro(c, errorsCustom.WrapErrorf(errorsCustom.ErrInvalidInput, "entrada invalida para login: %v", err))
+		return
+	}
+
+	ctx := c.Request.Context()
+	sessao, err := h.service.Login(ctx, credenciais)
+	if err != nil {
+		responderErro(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, sessao)
+}
+
+// Autenticar exige um token Bearer valido e registra o usuario no contexto da requisicao.
+func Autenticar(s service.AuthService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token, ok := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+		if !ok || strings.TrimSpace(token) == "" {
+			c.Header("WWW-Authenticate", `Bearer realm="agenda"`)
+			responderErro(c, errorsCustom.WrapErrorf(errorsCustom.ErrUnauthorized, "cabecalho Authorization com token Bearer e obrigatorio"))
+			c.Abort()
+			return
+		}
+
+		usuario, err := s.ValidarToken(c.Request.Context(), strings.TrimSpace(token))
+		if err != nil {
+			c.Header("WWW-Authenticate", `Bearer realm="agenda", error="invalid_token"`)
+			responderErro(c, err)
+			c.Abort()
+			return
+		}
+
+		c.Request = c.Request.WithContext(requisicao.ComUsuario(c.Request.Context(), usuario))
+		c.Next()
+	}
+}
+
+var (
+	exigirEditor = ExigirPapel(entity.PapelEditor)
+	exigirAdmin  = ExigirPapel(entity.PapelAdmin)
+)
+
+// ExigirPapel recusa a requisicao quando o usuario autenticado nao tem ao menos o papel informado.
+func ExigirPapel(minimo entity.Papel) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		usuario, ok := requisicao.Usuario(c.Request.Context())
+		if !ok {
+			responderErro(c, errorsCustom.WrapErrorf(errorsCustom.ErrUnauthorized, "requisicao sem usuario autenticado"))
+			c.Abort()
+			return
+		}
+		if !usuario.Papel.Permite(minimo) {
+			responderErro(c, errorsCustom.WrapErrorf(errorsCustom.ErrForbidden, "papel %q nao permite %s %s, exige %q", usuario.Papel, c.Request.Method, c.FullPath(), minimo))
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
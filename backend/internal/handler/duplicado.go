@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	errorsCustom "github.com/robitooS/backend/internal/errors"
+)
+
+type requisicaoMesclagem struct {
+	IDs       []int64 `json:"ids"`
+	Principal int64   `json:"principal"`
+}
+
+func (h *ContatoHandler) GetDuplicados(c *gin.Context) {
+	var similaridade float64
+	if valor := c.Query("similaridade"); valor != "" {
+		numero, err := strconv.ParseFloat(valor, 64)
+		if err != nil {
+			h.handleError(c, errorsCustom.WrapErrorf(errorsCustom.ErrInvalidInput, "entrada invalida para similaridade %q", valor))
+			return
+		}
+		similaridade = numero
+	}
+
+	ctx := c.Request.Context()
+	grupos, err := h.service.FindDuplicados(ctx, similaridade)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, grupos)
+}
+
+func (h *ContatoHandler) MesclarContatos(c *gin.Context) {
+	var req requisicaoMesclagem
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.handleError(c, errorsCustom.WrapErrorf(errorsCustom.ErrInvalidInput, "entrada invalida para mesclagem de contatos: %v", err))
+		return
+	}
+
+	ctx := c.Request.Context()
+	contato, err := h.service.Mesclar(ctx, req.IDs, req.Principal)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+	for _, id := range req.IDs {
+		if id != contato.ID {
+			h.delLogger.LogDeletedContact(ctx, id)
+		}
+	}
+	c.Header("ETag", etagDaVersao(contato.Versao))
+	c.JSON(http.StatusOK, contato)
+}
@@ -0,0 +1,89 @@
+package handler
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/robitooS/backend/internal/foto"
+
+	errorsCustom "github.com/robitooS/backend/internal/errors"
+)
+
+func (h *ContatoHandler) PutFoto(c *gin.Context) {
+	id, err := parametroID(c, "id")
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	// Margem para os cabecalhos do multipart alem do limite da imagem
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, foto.TamanhoMaximo+64<<10)
+	arquivo, err := c.FormFile("foto")
+	if err != nil {
+		h.handleError(c, errorsCustom.WrapErrorf(errorsCustom.ErrInvalidInput, "envie a imagem no campo multipart 'foto' com ate %d MB: %v", foto.TamanhoMaximo>>20, err))
+		return
+	}
+	if arquivo.Size > foto.TamanhoMaximo {
+		h.handleError(c, errorsCustom.WrapErrorf(errorsCustom.ErrInvalidInput, "foto excede %d MB", foto.TamanhoMaximo>>20))
+		return
+	}
+
+	conteudo, err := arquivo.Open()
+	if err != nil {
+		h.handleError(c, errorsCustom.WrapErrorf(err, "falha ao ler foto enviada"))
+		return
+	}
+	defer conteudo.Close()
+	dados, err := io.ReadAll(conteudo)
+	if err != nil {
+		h.handleError(c, errorsCustom.WrapErrorf(err, "falha ao ler foto enviada"))
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := h.service.SalvarFoto(ctx, id, dados); err != nil {
+		h.handleError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *ContatoHandler) GetFoto(c *gin.Context) {
+	id, err := parametroID(c, "id")
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+	variante := foto.Variante(c.DefaultQuery("tamanho", string(foto.VarianteOriginal)))
+
+	ctx := c.Request.Context()
+	arquivo, err := h.service.AbrirFoto(ctx, id, variante)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+	defer arquivo.Conteudo.Close()
+
+	// ServeContent responde 304 para If-None-Match e If-Modified-Since
+	c.Header("Content-Type", arquivo.ContentType)
+	c.Header("Cache-Control", "private, max-age=300")
+	c.Header("ETag", fmt.Sprintf(`"%s-%x-%x"`, variante, arquivo.ModificadoEm.UnixNano(), arquivo.Tamanho))
+	http.ServeContent(c.Writer, c.Request, "", arquivo.ModificadoEm, arquivo.Conteudo)
+}
+
+func (h *ContatoHandler) DeleteFoto(c *gin.Context) {
+	id, err := parametroID(c, "id")
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := h.service.RemoverFoto(ctx, id); err != nil {
+		h.handleError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,173 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/robitooS/backend/internal/entity"
+	"github.com/robitooS/backend/internal/service"
+
+	errorsCustom "github.com/robitooS/backend/internal/errors"
+)
+
+type GrupoHandler struct {
+	service service.GrupoService
+}
+
+func NewGrupoHandler(s service.GrupoService) *GrupoHandler {
+	return &GrupoHandler{service: s}
+}
+
+// RegisterAgendaRoutes registra as rotas no grupo /agendas/:agendaId; cada agenda tem seus proprios grupos.
+func (h *GrupoHandler) RegisterAgendaRoutes(router gin.IRouter) {
+	router.POST("/grupos", exigirEditor, h.CreateGrupo)
+	router.GET("/grupos", h.GetGrupos)
+	router.GET("/grupos/:id", h.GetGrupoByID)
+	router.PUT("/grupos/:id", exigirEditor, h.UpdateGrupo)
+	router.DELETE("/grupos/:id", exigirEditor, h.DeleteGrupo)
+	router.PUT("/grupos/:id/contatos/:contatoId", exigirEditor, h.AdicionarContato)
+	router.DELETE("/grupos/:id/contatos/:contatoId", exigirEditor, h.RemoverContato)
+
+	router.GET("/contatos/:id/grupos", h.GetGruposDoContato)
+}
+
+func (h *GrupoHandler) CreateGrupo(c *gin.Context) {
+	var grupo entity.Grupo
+	if err := c.ShouldBindJSON(&grupo); err != nil {
+		responderErro(c, errorsCustom.WrapErrorf(errorsCustom.ErrInvalidInput, "entrada invalida para criacao de grupo: %v", err))
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := h.service.Create(ctx, &grupo); err != nil {
+		responderErro(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, grupo)
+}
+
+func (h *GrupoHandler) GetGrupos(c *gin.Context) {
+	ctx := c.Request.Context()
+	grupos, err := h.service.FindAll(ctx)
+	if err != nil {
+		responderErro(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, grupos)
+}
+
+func (h *GrupoHandler) GetGrupoByID(c *gin.Context) {
+	id, err := parametroID(c, "id")
+	if err != nil {
+		responderErro(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	grupo, err := h.service.FindByID(ctx, id)
+	if err != nil {
+		responderErro(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, grupo)
+}
+
+func (h *GrupoHandler) UpdateGrupo(c *gin.Context) {
+	id, err := parametroID(c, "id")
+	if err != nil {
+		responderErro(c, err)
+		return
+	}
+
+	var grupo entity.Grupo
+	if err := c.ShouldBindJSON(&grupo); err != nil {
+		responderErro(c, errorsCustom.WrapErrorf(errorsCustom.ErrInvalidInput, "entrada invalida para atualizacao de grupo: %v", err))
+		return
+	}
+	grupo.ID = id
+
+	ctx := c.Request.Context()
+	if err := h.service.Update(ctx, &grupo); err != nil {
+		responderErro(c, err)
+		return
+	}
+	atualizado, err := h.service.FindByID(ctx, id)
+	if err != nil {
+		responderErro(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, atualizado)
+}
+
+func (h *GrupoHandler) DeleteGrupo(c *gin.Context) {
+	id, err := parametroID(c, "id")
+	if err != nil {
+		responderErro(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := h.service.Delete(ctx, id); err != nil {
+		responderErro(c, err)
+		return
+	}
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h *GrupoHandler) AdicionarContato(c *gin.Context) {
+	idGrupo, idContato, err := parametrosMembro(c)
+	if err != nil {
+		responderErro(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := h.service.AdicionarContato(ctx, idGrupo, idContato); err != nil {
+		responderErro(c, err)
+		return
+	}
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h *GrupoHandler) RemoverContato(c *gin.Context) {
+	idGrupo, idContato, err := parametrosMembro(c)
+	if err != nil {
+		responderErro(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := h.service.RemoverContato(ctx, idGrupo, idContato); err != nil {
+		responderErro(c, err)
+		return
+	}
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h *GrupoHandler) GetGruposDoContato(c *gin.Context) {
+	idContato, err := parametroID(c, "id")
+	if err != nil {
+		responderErro(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	grupos, err := h.service.FindByContato(ctx, idContato)
+	if err != nil {
+		responderErro(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, grupos)
+}
+
+func parametrosMembro(c *gin.Context) (int64, int64, error) {
+	idGrupo, err := parametroID(c, "id")
+	if err != nil {
+		return 0, 0, err
+	}
+	idContato, err := parametroID(c, "contatoId")
+	if err != nil {
+		return 0, 0, err
+	}
+	return idGrupo, idContato, nil
+}
@@ -5,6 +5,7 @@ import (
 	"log"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/robitooS/backend/internal/entity"
@@ -15,47 +16,81 @@ import (
 )
 
 type ContatoHandler struct {
-	service service.ContatoService
-	delLogPath string
+	service   service.ContatoService
+	delLogger *logger.DeletionLogger
 }
 
-func NewContatoHandler(s service.ContatoService, delLogPath string) *ContatoHandler {
-	return &ContatoHandler{service: s, delLogPath: delLogPath}
+func NewContatoHandler(s service.ContatoService, delLogger *logger.DeletionLogger) *ContatoHandler {
+	return &ContatoHandler{service: s, delLogger: delLogger}
 }
 
 func (h *ContatoHandler) handleError(c *gin.Context, err error) {
+	responderErro(c, err)
+}
+
+func responderErro(c *gin.Context, err error) {
 	log.Printf("DEBUG HANDLER: Erro recebido: %v, tipo: %T", err, err)
-	var apiError *errorsCustom.APIError
+	status, apiError := respostaDeErro(err)
+	c.JSON(status, apiError)
+}
 
+func respostaDeErro(err error) (int, *errorsCustom.APIError) {
 	if errors.Is(err, errorsCustom.ErrNotFound) {
-		apiError = errorsCustom.NewAPIError("NAO_ENCONTRADO", "Recurso nao encontrado", err.Error())
-		c.JSON(http.StatusNotFound, apiError)
-		return
+		return http.StatusNotFound, errorsCustom.NewAPIError("NAO_ENCONTRADO", "Recurso nao encontrado", err.Error())
 	}
 	if errors.Is(err, errorsCustom.ErrInvalidInput) {
-		apiError = errorsCustom.NewAPIError("ENTRADA_INVALIDA", "Dados de entrada invalidos", err.Error())
-		c.JSON(http.StatusBadRequest, apiError)
-		return
+		return http.StatusBadRequest, errorsCustom.NewAPIError("ENTRADA_INVALIDA", "Dados de entrada invalidos", err.Error())
 	}
 	if errors.Is(err, errorsCustom.ErrAlreadyExists) {
-		apiError = errorsCustom.NewAPIError("JA_EXISTE", "Recurso ja existe", err.Error())
-		c.JSON(http.StatusConflict, apiError)
-		return
+		return http.StatusConflict, errorsCustom.NewAPIError("JA_EXISTE", "Recurso ja existe", err.Error())
+	}
+	if errors.Is(err, errorsCustom.ErrUnauthorized) {
+		return http.StatusUnauthorized, errorsCustom.NewAPIError("NAO_AUTENTICADO", "Autenticacao necessaria", err.Error())
+	}
+	if errors.Is(err, errorsCustom.ErrForbidden) {
+		return http.StatusForbidden, errorsCustom.NewAPIError("ACESSO_NEGADO", "Usuario sem permissao para esta operacao", err.Error())
+	}
+	if errors.Is(err, errorsCustom.ErrVersionConflict) {
+		return http.StatusPreconditionFailed, errorsCustom.NewAPIError("VERSAO_CONFLITANTE", "O recurso foi alterado por outra requisicao", err.Error())
 	}
 
 	// Para outros erros (incluindo os wrapped de DB), retornar um erro interno genérico
 	// Isso evita vazar detalhes internos para o cliente da API
-	apiError = errorsCustom.NewAPIError("ERRO_INTERNO_SERVE", "Ocorreu um erro interno no servidor", "Por favor, tente novamente mais tarde.")
-	c.JSON(http.StatusInternalServerError, apiError)
 	log.Printf("Erro interno: %v", err)
+	return http.StatusInternalServerError, errorsCustom.NewAPIError("ERRO_INTERNO_SERVE", "Ocorreu um erro interno no servidor", "Por favor, tente novamente mais tarde.")
 }
 
-func (h *ContatoHandler) RegisterRoutes(router *gin.Engine) {
-	router.POST("/contatos", h.CreateContato)
+func (h *ContatoHandler) RegisterRoutes(router gin.IRouter) {
+	router.POST("/contatos", exigirEditor, h.CreateContato)
+	router.POST("/contatos/lote", exigirEditor, h.CreateLote)
+	router.POST("/contatos/mesclar", exigirEditor, h.MesclarContatos)
 	router.GET("/contatos", h.GetContatos)
+	router.GET("/contatos/duplicados", h.GetDuplicados)
+	router.GET("/contatos/aniversariantes", h.GetAniversariantes)
+	router.GET("/contatos/export.csv", h.ExportarCSV)
+	router.GET("/contatos/export.vcf", h.ExportarVCF)
+	router.POST("/contatos/import", exigirEditor, h.ImportarContatos)
 	router.GET("/contatos/:id", h.GetContatoByID)
-	router.PUT("/contatos/:id", h.UpdateContato)
-	router.DELETE("/contatos/:id", h.DeleteContato)
+	router.GET("/contatos/:id/vcard", h.GetContatoVCard)
+	router.PUT("/contatos/:id", exigirEditor, h.UpdateContato)
+	router.PATCH("/contatos/:id", exigirEditor, h.PatchContato)
+	router.DELETE("/contatos/:id", exigirEditor, h.DeleteContato)
+	router.GET("/contatos/lixeira", h.GetLixeira)
+	router.POST("/contatos/:id/restaurar", exigirEditor, h.RestaurarContato)
+	router.DELETE("/contatos/lixeira/:id", exigirAdmin, h.PurgarContato)
+
+	router.GET("/contatos/:id/telefones", h.GetTelefones)
+	router.POST("/contatos/:id/telefones", exigirEditor, h.CreateTelefone)
+	router.GET("/contatos/:id/telefones/:telefoneId", h.GetTelefone)
+	router.PUT("/contatos/:id/telefones/:telefoneId", exigirEditor, h.UpdateTelefone)
+	router.DELETE("/contatos/:id/telefones/:telefoneId", exigirEditor, h.DeleteTelefone)
+
+	router.GET("/contatos/:id/revisoes", h.GetRevisoes)
+	router.POST("/contatos/:id/revisoes/:rev/reverter", exigirEditor, h.ReverterContato)
+
+	router.PUT("/contatos/:id/foto", exigirEditor, h.PutFoto)
+	router.GET("/contatos/:id/foto", h.GetFoto)
+	router.DELETE("/contatos/:id/foto", exigirEditor, h.DeleteFoto)
 }
 
 func (h *ContatoHandler) CreateContato(c *gin.Context) {
@@ -70,20 +105,81 @@ func (h *ContatoHandler) CreateContato(c *gin.Context) {
 		h.handleError(c, err)
 		return
 	}
+	c.Header("ETag", etagDaVersao(contato.Versao))
 	c.JSON(http.StatusCreated, contato)
 }
 
 func (h *ContatoHandler) GetContatos(c *gin.Context) {
-	nome := c.Query("nome")
-	numero := c.Query("numero")
+	filtro, err := filtroDaQuery(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	pag, err := paginacaoDaQuery(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
 
 	ctx := c.Request.Context()
-	contatos, err := h.service.FindWithFilters(ctx, nome, numero)
+	pagina, err := h.service.FindWithFilters(ctx, filtro, pag)
 	if err != nil {
 		h.handleError(c, err)
 		return
 	}
-	c.JSON(http.StatusOK, contatos)
+
+	h.responderPagina(c, pagina)
+}
+
+func (h *ContatoHandler) responderPagina(c *gin.Context, pagina *entity.PaginaContatos) {
+	if pagina.ProximoCursor != "" {
+		c.Header("X-Next-Cursor", pagina.ProximoCursor)
+	}
+	if pagina.Total != nil {
+		c.Header("X-Total-Count", strconv.FormatInt(*pagina.Total, 10))
+	}
+	c.JSON(http.StatusOK, pagina.Contatos)
+}
+
+func filtroDaQuery(c *gin.Context) (entity.FiltroContato, error) {
+	filtro := entity.FiltroContato{
+		Nome:   c.Query("nome"),
+		Numero: c.Query("numero"),
+		Tipo:   entity.TipoTelefone(c.Query("tipo_telefone")),
+		Email:  c.Query("email"),
+		Cidade: c.Query("cidade"),
+		UF:     c.Query("uf"),
+		Busca:  entity.ModoBusca(c.Query("busca")),
+		Ordem:  entity.Ordenacao(c.Query("ordem")),
+	}
+
+	if grupo := c.Query("grupo"); grupo != "" {
+		id, err := strconv.ParseInt(grupo, 10, 64)
+		if err != nil || id <= 0 {
+			return filtro, errorsCustom.WrapErrorf(errorsCustom.ErrInvalidInput, "entrada invalida para grupo %q", grupo)
+		}
+		filtro.Grupo = id
+	}
+
+	return filtro, nil
+}
+
+func paginacaoDaQuery(c *gin.Context) (entity.Paginacao, error) {
+	pag := entity.Paginacao{
+		Cursor:       c.Query("cursor"),
+		IncluirTotal: c.Query("total") == "true",
+	}
+
+	if limite := c.Query("limit"); limite != "" {
+		valor, err := strconv.Atoi(limite)
+		if err != nil {
+			return pag, errorsCustom.WrapErrorf(errorsCustom.ErrInvalidInput, "entrada invalida para limit %q", limite)
+		}
+		pag.Limite = valor
+	}
+
+	return pag, nil
 }
 
 func (h *ContatoHandler) GetContatoByID(c *gin.Context) {
@@ -99,6 +195,7 @@ func (h *ContatoHandler) GetContatoByID(c *gin.Context) {
 		h.handleError(c, err)
 		return
 	}
+	c.Header("ETag", etagDaVersao(contato.Versao))
 	c.JSON(http.StatusOK, contato)
 }
 
@@ -116,14 +213,74 @@ func (h *ContatoHandler) UpdateContato(c *gin.Context) {
 	}
 	contato.ID = id // Garante que o ID da URL seja usado
 
+	versao, err := versaoDoIfMatch(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+	contato.Versao = versao
+
 	ctx := c.Request.Context()
 	if err := h.service.Update(ctx, &contato); err != nil {
 		h.handleError(c, err)
 		return
 	}
+	c.Header("ETag", etagDaVersao(contato.Versao))
 	c.JSON(http.StatusOK, contato)
 }
 
+func (h *ContatoHandler) PatchContato(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		h.handleError(c, errorsCustom.WrapErrorf(err, "entrada invalida para ID do contato"))
+		return
+	}
+
+	if tipo := c.ContentType(); tipo != "application/merge-patch+json" && tipo != "application/json" {
+		h.handleError(c, errorsCustom.WrapErrorf(errorsCustom.ErrInvalidInput, "Content-Type %q nao suportado, use application/merge-patch+json", tipo))
+		return
+	}
+
+	patch, err := c.GetRawData()
+	if err != nil {
+		h.handleError(c, errorsCustom.WrapErrorf(errorsCustom.ErrInvalidInput, "entrada invalida para atualizacao parcial de contato: %v", err))
+		return
+	}
+
+	versao, err := versaoDoIfMatch(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	contato, err := h.service.Patch(ctx, id, patch, versao)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+	c.Header("ETag", etagDaVersao(contato.Versao))
+	c.JSON(http.StatusOK, contato)
+}
+
+func etagDaVersao(versao int64) string {
+	return `"` + strconv.FormatInt(versao, 10) + `"`
+}
+
+func versaoDoIfMatch(c *gin.Context) (int64, error) {
+	ifMatch := strings.TrimSpace(c.GetHeader("If-Match"))
+	if ifMatch == "" || ifMatch == "*" {
+		return 0, nil
+	}
+
+	etag := strings.Trim(strings.TrimPrefix(ifMatch, "W/"), `"`)
+	versao, err := strconv.ParseInt(etag, 10, 64)
+	if err != nil || versao <= 0 {
+		return 0, errorsCustom.WrapErrorf(errorsCustom.ErrInvalidInput, "entrada invalida para If-Match %q", ifMatch)
+	}
+	return versao, nil
+}
+
 func (h *ContatoHandler) DeleteContato(c *gin.Context) {
 	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
 	if err != nil {
@@ -136,6 +293,78 @@ func (h *ContatoHandler) DeleteContato(c *gin.Context) {
 		h.handleError(c, err)
 		return
 	}
-	logger.LogDeletedContact(h.delLogPath, id)
+	h.delLogger.LogDeletedContact(ctx, id)
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func (h *ContatoHandler) GetAniversariantes(c *gin.Context) {
+	dias := service.DiasAniversariantesPadrao
+	if valor := c.Query("dias"); valor != "" {
+		numero, err := strconv.Atoi(valor)
+		if err != nil {
+			h.handleError(c, errorsCustom.WrapErrorf(errorsCustom.ErrInvalidInput, "entrada invalida para dias %q", valor))
+			return
+		}
+		dias = numero
+	}
+
+	ctx := c.Request.Context()
+	aniversariantes, err := h.service.FindAniversariantes(ctx, dias)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, aniversariantes)
+}
+
+func (h *ContatoHandler) GetLixeira(c *gin.Context) {
+	pag, err := paginacaoDaQuery(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	pagina, err := h.service.FindDeleted(ctx, pag)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+	h.responderPagina(c, pagina)
+}
+
+func (h *ContatoHandler) RestaurarContato(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		h.handleError(c, errorsCustom.WrapErrorf(err, "entrada invalida para ID do contato"))
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := h.service.Restore(ctx, id); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	contato, err := h.service.FindByID(ctx, id)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, contato)
+}
+
+func (h *ContatoHandler) PurgarContato(c *gin.Context) {
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		h.handleError(c, errorsCustom.WrapErrorf(err, "entrada invalida para ID do contato"))
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := h.service.Purge(ctx, id); err != nil {
+		h.handleError(c, err)
+		return
+	}
 	c.JSON(http.StatusNoContent, nil)
 }
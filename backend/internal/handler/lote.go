@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/robitooS/backend/internal/entity"
+
+	errorsCustom "github.com/robitooS/backend/internal/errors"
+)
+
+const (
+	modoLoteAtomico = "atomico"
+	modoLoteParcial = "parcial"
+)
+
+type resultadoLote struct {
+	Indice  int                    `json:"indice"`
+	Status  int                    `json:"status"`
+	Contato *entity.Contato        `json:"contato,omitempty"`
+	Erro    *errorsCustom.APIError `json:"erro,omitempty"`
+}
+
+type respostaLote struct {
+	Modo       string          `json:"modo"`
+	Criados    int             `json:"criados"`
+	Falhas     int             `json:"falhas"`
+	Resultados []resultadoLote `json:"resultados"`
+}
+
+func (h *ContatoHandler) CreateLote(c *gin.Context) {
+	modo := c.DefaultQuery("modo", modoLoteAtomico)
+	if modo != modoLoteAtomico && modo != modoLoteParcial {
+		h.handleError(c, errorsCustom.WrapErrorf(errorsCustom.ErrInvalidInput, "modo de lote %q invalido, use %s ou %s", modo, modoLoteAtomico, modoLoteParcial))
+		return
+	}
+
+	var contatos []*entity.Contato
+	if err := c.ShouldBindJSON(&contatos); err != nil {
+		h.handleError(c, errorsCustom.WrapErrorf(errorsCustom.ErrInvalidInput, "entrada invalida para criacao de contatos em lote: %v", err))
+		return
+	}
+	for i, contato := range contatos {
+		if contato == nil {
+			h.handleError(c, errorsCustom.WrapErrorf(errorsCustom.ErrInvalidInput, "contato %d do lote esta vazio", i))
+			return
+		}
+	}
+
+	ctx := c.Request.Context()
+	atomico := modo == modoLoteAtomico
+	erros, err := h.service.CreateLote(ctx, contatos, atomico)
+	if err != nil && erros == nil {
+		h.handleError(c, err)
+		return
+	}
+
+	resposta := respostaLote{Modo: modo, Resultados: make([]resultadoLote, len(contatos))}
+	status := http.StatusCreated
+	for i, contato := range contatos {
+		resultado := resultadoLote{Indice: i}
+		switch {
+		case erros[i] != nil:
+			resultado.Status, resultado.Erro = respostaDeErro(erros[i])
+			resposta.Falhas++
+		case err != nil:
+			resultado.Status = http.StatusFailedDependency
+			resultado.Erro = errorsCustom.NewAPIError("NAO_PROCESSADO", "Contato nao criado porque o lote foi revertido")
+		default:
+			resultado.Status = http.StatusCreated
+			resultado.Contato = contato
+			resposta.Criados++
+		}
+		resposta.Resultados[i] = resultado
+	}
+
+	if err != nil {
+		status, _ = respostaDeErro(err)
+	} else if resposta.Falhas > 0 {
+		status = http.StatusMultiStatus
+	}
+	c.JSON(status, resposta)
+}
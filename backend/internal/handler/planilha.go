@@ -0,0 +1,142 @@
+package handler
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+	"github.com/robitooS/backend/internal/entity"
+	"github.com/robitooS/backend/internal/planilha"
+	"github.com/robitooS/backend/internal/service"
+	"github.com/robitooS/backend/internal/vcard"
+
+	errorsCustom "github.com/robitooS/backend/internal/errors"
+)
+
+const tamanhoMaximoImportacao = 10 << 20
+
+type erroImportacao struct {
+	Linha int                    `json:"linha"`
+	Erro  *errorsCustom.APIError `json:"erro"`
+}
+
+type relatorioImportacao struct {
+	Linhas     int              `json:"linhas"`
+	Importados int              `json:"importados"`
+	Erros      []erroImportacao `json:"erros"`
+}
+
+func (h *ContatoHandler) ExportarCSV(c *gin.Context) {
+	filtro, err := filtroDaQuery(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	contatos, err := h.service.FindAllWithFilters(ctx, filtro)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Header("Content-Disposition", `attachment; filename="contatos.csv"`)
+	c.Status(http.StatusOK)
+	if err := planilha.Escrever(c.Writer, contatos); err != nil {
+		c.Error(err)
+	}
+}
+
+func (h *ContatoHandler) ImportarContatos(c *gin.Context) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, tamanhoMaximoImportacao)
+
+	cabecalho, err := c.FormFile("arquivo")
+	if err != nil {
+		h.handleError(c, errorsCustom.WrapErrorf(errorsCustom.ErrInvalidInput, "arquivo de importacao ausente ou invalido: %v", err))
+		return
+	}
+
+	var mapeamento *planilha.Mapeamento
+	if valor := c.PostForm("mapeamento"); valor != "" {
+		mapeamento = &planilha.Mapeamento{}
+		if err := json.Unmarshal([]byte(valor), mapeamento); err != nil {
+			h.handleError(c, errorsCustom.WrapErrorf(errorsCustom.ErrInvalidInput, "mapeamento de colunas invalido: %v", err))
+			return
+		}
+	}
+
+	arquivo, err := cabecalho.Open()
+	if err != nil {
+		h.handleError(c, errorsCustom.WrapErrorf(err, "falha ao abrir arquivo de importacao"))
+		return
+	}
+	defer arquivo.Close()
+
+	var linhas []planilha.Linha
+	if ehVCard(cabecalho) {
+		linhas, err = lerVCard(arquivo)
+	} else {
+		linhas, err = planilha.Ler(arquivo, mapeamento)
+	}
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	h.importarLinhas(c, linhas)
+}
+
+func lerVCard(arquivo io.Reader) ([]planilha.Linha, error) {
+	contatos, err := vcard.Ler(arquivo)
+	if err != nil {
+		return nil, err
+	}
+
+	linhas := make([]planilha.Linha, len(contatos))
+	for i, contato := range contatos {
+		linhas[i] = planilha.Linha{Numero: i + 1, Contato: contato}
+	}
+	return linhas, nil
+}
+
+func (h *ContatoHandler) importarLinhas(c *gin.Context, linhas []planilha.Linha) {
+	relatorio := relatorioImportacao{Linhas: len(linhas), Erros: []erroImportacao{}}
+
+	var validos []*entity.Contato
+	var numeros []int
+	for _, linha := range linhas {
+		if linha.Erro != nil {
+			_, apiError := respostaDeErro(linha.Erro)
+			relatorio.Erros = append(relatorio.Erros, erroImportacao{Linha: linha.Numero, Erro: apiError})
+			continue
+		}
+		validos = append(validos, linha.Contato)
+		numeros = append(numeros, linha.Numero)
+	}
+
+	ctx := c.Request.Context()
+	for inicio := 0; inicio < len(validos); inicio += service.TamanhoMaximoLote {
+		fim := min(inicio+service.TamanhoMaximoLote, len(validos))
+		erros, err := h.service.CreateLote(ctx, validos[inicio:fim], false)
+		if err != nil {
+			h.handleError(c, err)
+			return
+		}
+		for i, errLinha := range erros {
+			if errLinha != nil {
+				_, apiError := respostaDeErro(errLinha)
+				relatorio.Erros = append(relatorio.Erros, erroImportacao{Linha: numeros[inicio+i], Erro: apiError})
+				continue
+			}
+			relatorio.Importados++
+		}
+	}
+
+	sort.Slice(relatorio.Erros, func(i, j int) bool {
+		return relatorio.Erros[i].Linha < relatorio.Erros[j].Linha
+	})
+	c.JSON(http.StatusOK, relatorio)
+}
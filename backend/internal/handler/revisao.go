@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (h *ContatoHandler) GetRevisoes(c *gin.Context) {
+	id, err := parametroID(c, "id")
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	revisoes, err := h.service.FindRevisoes(ctx, id)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, revisoes)
+}
+
+func (h *ContatoHandler) ReverterContato(c *gin.Context) {
+	id, err := parametroID(c, "id")
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+	rev, err := parametroID(c, "rev")
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+	versao, err := versaoDoIfMatch(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	contato, err := h.service.Reverter(ctx, id, rev, versao)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+	c.Header("ETag", etagDaVersao(contato.Versao))
+	c.JSON(http.StatusOK, contato)
+}
@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/robitooS/backend/internal/entity"
+
+	errorsCustom "github.com/robitooS/backend/internal/errors"
+)
+
+func (h *ContatoHandler) GetTelefones(c *gin.Context) {
+	idContato, err := parametroID(c, "id")
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	telefones, err := h.service.FindTelefones(ctx, idContato)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, telefones)
+}
+
+func (h *ContatoHandler) GetTelefone(c *gin.Context) {
+	idContato, err := parametroID(c, "id")
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+	id, err := parametroID(c, "telefoneId")
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	telefone, err := h.service.FindTelefone(ctx, idContato, id)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, telefone)
+}
+
+func (h *ContatoHandler) CreateTelefone(c *gin.Context) {
+	idContato, err := parametroID(c, "id")
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	var telefone entity.Telefone
+	if err := c.ShouldBindJSON(&telefone); err != nil {
+		h.handleError(c, errorsCustom.WrapErrorf(errorsCustom.ErrInvalidInput, "entrada invalida para criacao de telefone: %v", err))
+		return
+	}
+	telefone.IDContato = idContato
+
+	ctx := c.Request.Context()
+	if err := h.service.CreateTelefone(ctx, &telefone); err != nil {
+		h.handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, telefone)
+}
+
+func (h *ContatoHandler) UpdateTelefone(c *gin.Context) {
+	idContato, err := parametroID(c, "id")
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+	id, err := parametroID(c, "telefoneId")
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	var telefone entity.Telefone
+	if err := c.ShouldBindJSON(&telefone); err != nil {
+		h.handleError(c, errorsCustom.WrapErrorf(errorsCustom.ErrInvalidInput, "entrada invalida para atualizacao de telefone: %v", err))
+		return
+	}
+	telefone.IDContato = idContato
+	telefone.ID = id
+
+	ctx := c.Request.Context()
+	if err := h.service.UpdateTelefone(ctx, &telefone); err != nil {
+		h.handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, telefone)
+}
+
+func (h *ContatoHandler) DeleteTelefone(c *gin.Context) {
+	idContato, err := parametroID(c, "id")
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+	id, err := parametroID(c, "telefoneId")
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := h.service.DeleteTelefone(ctx, idContato, id); err != nil {
+		h.handleError(c, err)
+		return
+	}
+	c.JSON(http.StatusNoContent, nil)
+}
+
+func parametroID(c *gin.Context, nome string) (int64, error) {
+	id, err := strconv.ParseInt(c.Param(nome), 10, 64)
+	if err != nil {
+		return 0, errorsCustom.WrapErrorf(errorsCustom.ErrInvalidInput, "entrada invalida para o parametro %s", nome)
+	}
+	return id, nil
+}
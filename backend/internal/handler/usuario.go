@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/robitooS/backend/internal/entity"
+	"github.com/robitooS/backend/internal/requisicao"
+	"github.com/robitooS/backend/internal/service"
+
+	errorsCustom "github.com/robitooS/backend/internal/errors"
+)
+
+type UsuarioHandler struct {
+	service service.UsuarioService
+}
+
+func NewUsuarioHandler(s service.UsuarioService) *UsuarioHandler {
+	return &UsuarioHandler{service: s}
+}
+
+func (h *UsuarioHandler) RegisterRoutes(router gin.IRouter) {
+	router.GET("/auth/eu", h.GetUsuarioAtual)
+	router.POST("/usuarios", exigirAdmin, h.CreateUsuario)
+	router.GET("/usuarios", exigirAdmin, h.GetUsuarios)
+	router.GET("/usuarios/:id", exigirAdmin, h.GetUsuarioByID)
+	router.PUT("/usuarios/:id/papel", exigirAdmin, h.AlterarPapel)
+}
+
+func (h *UsuarioHandler) GetUsuarioAtual(c *gin.Context) {
+	usuario, ok := requisicao.Usuario(c.Request.Context())
+	if !ok {
+		responderErro(c, errorsCustom.ErrUnauthorized)
+		return
+	}
+	c.JSON(http.StatusOK, usuario)
+}
+
+func (h *UsuarioHandler) CreateUsuario(c *gin.Context) {
+	var usuario entity.Usuario
+	if err := c.ShouldBindJSON(&usuario); err != nil {
+		responderErro(c, errorsCustom.WrapErrorf(errorsCustom.ErrInvalidInput, "entrada invalida para criacao de usuario: %v", err))
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := h.service.Create(ctx, &usuario); err != nil {
+		responderErro(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, usuario)
+}
+
+func (h *UsuarioHandler) GetUsuarios(c *gin.Context) {
+	ctx := c.Request.Context()
+	usuarios, err := h.service.FindAll(ctx)
+	if err != nil {
+		responderErro(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, usuarios)
+}
+
+func (h *UsuarioHandler) GetUsuarioByID(c *gin.Context) {
+	id, err := parametroID(c, "id")
+	if err != nil {
+		responderErro(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	usuario, err := h.service.FindByID(ctx, id)
+	if err != nil {
+		responderErro(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, usuario)
+}
+
+func (h *UsuarioHandler) AlterarPapel(c *gin.Context) {
+	id, err := parametroID(c, "id")
+	if err != nil {
+		responderErro(c, err)
+		return
+	}
+	var corpo struct {
+		Papel entity.Papel `json:"papel"`
+	}
+	if err := c.ShouldBindJSON(&corpo); err != nil {
+		responderErro(c, errorsCustom.WrapErrorf(errorsCustom.ErrInvalidInput, "entrada invalida para alteracao de papel: %v", err))
+		return
+	}
+
+	ctx := c.Request.Context()
+	usuario, err := h.service.AlterarPapel(ctx, id, corpo.Papel)
+	if err != nil {
+		responderErro(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, usuario)
+}
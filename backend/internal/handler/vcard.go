@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/robitooS/backend/internal/entity"
+	"github.com/robitooS/backend/internal/vcard"
+
+	errorsCustom "github.com/robitooS/backend/internal/errors"
+)
+
+func (h *ContatoHandler) GetContatoVCard(c *gin.Context) {
+	id, err := parametroID(c, "id")
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	contato, err := h.service.FindByID(ctx, id)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+	h.responderVCard(c, fmt.Sprintf("contato-%d.vcf", id), contato)
+}
+
+func (h *ContatoHandler) ExportarVCF(c *gin.Context) {
+	filtro, err := filtroDaQuery(c)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	contatos, err := h.service.FindAllWithFilters(ctx, filtro)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+	h.responderVCard(c, "contatos.vcf", contatos...)
+}
+
+func (h *ContatoHandler) responderVCard(c *gin.Context, arquivo string, contatos ...*entity.Contato) {
+	versao := c.DefaultQuery("versao", vcard.Versao4)
+	if versao != vcard.Versao3 && versao != vcard.Versao4 {
+		h.handleError(c, errorsCustom.WrapErrorf(errorsCustom.ErrInvalidInput, "versao de vCard %q nao suportada, use %s ou %s", versao, vcard.Versao3, vcard.Versao4))
+		return
+	}
+
+	c.Header("Content-Type", vcard.ContentType)
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, arquivo))
+	c.Status(http.StatusOK)
+	if err := vcard.Escrever(c.Writer, versao, contatos...); err != nil {
+		c.Error(err)
+	}
+}
+
+func ehVCard(cabecalho *multipart.FileHeader) bool {
+	if strings.EqualFold(filepath.Ext(cabecalho.Filename), ".vcf") {
+		return true
+	}
+	tipo := strings.ToLower(cabecalho.Header.Get("Content-Type"))
+	return strings.HasPrefix(tipo, "text/vcard") || strings.HasPrefix(tipo, "text/x-vcard")
+}
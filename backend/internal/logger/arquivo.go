@@ -0,0 +1,161 @@
+package logger
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+var ErrSinkFechado = errors.New("logger: sink fechado")
+
+type ArquivoSink struct {
+	caminho  string
+	maxBytes int64
+	maxIdade time.Duration
+
+	mu      sync.RWMutex
+	fechado bool
+	linhas  chan []byte
+	feito   chan error
+
+	arquivo  *os.File
+	escritor *bufio.Writer
+	tamanho  int64
+	abertoEm time.Time
+}
+
+func NewArquivoSink(caminho string, maxBytes int64, maxIdade time.Duration, buffer int) (*ArquivoSink, error) {
+	if err := os.MkdirAll(filepath.Dir(caminho), 0755); err != nil {
+		return nil, fmt.Errorf("logger: falha ao criar diretorio do log %s: %w", caminho, err)
+	}
+
+	s := &ArquivoSink{
+		caminho:  caminho,
+		maxBytes: maxBytes,
+		maxIdade: maxIdade,
+		linhas:   make(chan []byte, buffer),
+		feito:    make(chan error, 1),
+	}
+	if err := s.abrir(); err != nil {
+		return nil, err
+	}
+
+	go s.executar()
+	return s, nil
+}
+
+func (s *ArquivoSink) Escrever(registro RegistroExclusao) error {
+	linha, err := linhaJSON(registro)
+	if err != nil {
+		return err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.fechado {
+		return ErrSinkFechado
+	}
+	s.linhas <- linha
+	return nil
+}
+
+func (s *ArquivoSink) Close() error {
+	s.mu.Lock()
+	if s.fechado {
+		s.mu.Unlock()
+		return nil
+	}
+	s.fechado = true
+	close(s.linhas)
+	s.mu.Unlock()
+
+	return <-s.feito
+}
+
+func (s *ArquivoSink) executar() {
+	for linha := range s.linhas {
+		if err := s.gravar(linha); err != nil {
+			log.Printf("ERRO: Falha ao escrever no arquivo de log %s: %v", s.caminho, err)
+		}
+		if len(s.linhas) == 0 {
+			if err := s.escritor.Flush(); err != nil {
+				log.Printf("ERRO: Falha ao descarregar o arquivo de log %s: %v", s.caminho, err)
+			}
+		}
+	}
+
+	err := s.escritor.Flush()
+	if errClose := s.arquivo.Close(); err == nil {
+		err = errClose
+	}
+	s.feito <- err
+}
+
+func (s *ArquivoSink) gravar(linha []byte) error {
+	if s.deveRotacionar(len(linha)) {
+		if err := s.rotacionar(); err != nil {
+			return err
+		}
+	}
+
+	n, err := s.escritor.Write(linha)
+	s.tamanho += int64(n)
+	return err
+}
+
+func (s *ArquivoSink) deveRotacionar(proxima int) bool {
+	if s.tamanho == 0 {
+		return false
+	}
+	if s.maxBytes > 0 && s.tamanho+int64(proxima) > s.maxBytes {
+		return true
+	}
+	return s.maxIdade > 0 && time.Since(s.abertoEm) >= s.maxIdade
+}
+
+func (s *ArquivoSink) rotacionar() error {
+	if err := s.escritor.Flush(); err != nil {
+		return fmt.Errorf("logger: falha ao descarregar %s antes da rotacao: %w", s.caminho, err)
+	}
+	if err := s.arquivo.Close(); err != nil {
+		return fmt.Errorf("logger: falha ao fechar %s antes da rotacao: %w", s.caminho, err)
+	}
+
+	if err := os.Rename(s.caminho, caminhoRotacionado(s.caminho, time.Now())); err != nil {
+		log.Printf("ERRO: Falha ao rotacionar o arquivo de log %s: %v", s.caminho, err)
+	}
+	return s.abrir()
+}
+
+func (s *ArquivoSink) abrir() error {
+	arquivo, err := os.OpenFile(s.caminho, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("logger: falha ao abrir o arquivo de log %s: %w", s.caminho, err)
+	}
+	info, err := arquivo.Stat()
+	if err != nil {
+		arquivo.Close()
+		return fmt.Errorf("logger: falha ao consultar o arquivo de log %s: %w", s.caminho, err)
+	}
+
+	s.arquivo = arquivo
+	s.escritor = bufio.NewWriter(arquivo)
+	s.tamanho = info.Size()
+	s.abertoEm = time.Now()
+	if s.tamanho > 0 {
+		s.abertoEm = info.ModTime()
+	}
+	return nil
+}
+
+func caminhoRotacionado(caminho string, agora time.Time) string {
+	ext := filepath.Ext(caminho)
+	base := strings.TrimSuffix(caminho, ext)
+	return base + "-" + agora.Format("20060102T150405.000") + ext
+}
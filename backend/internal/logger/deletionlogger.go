@@ -1,22 +1,43 @@
 package logger
 
 import (
-	"fmt"
+	"context"
 	"log"
-	"os"
 	"time"
+
+	"github.com/robitooS/backend/internal/requisicao"
 )
 
-func LogDeletedContact(logFilePath string, contactID int64) {
-	file, err := os.OpenFile(logFilePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
-	if err != nil {
-		log.Printf("ERRO: Falha ao abrir o arquivo de log %s: %v", logFilePath, err)
-		return // Não impede a operação principal se o log falhar
-	}
-	defer file.Close()
+type RegistroExclusao struct {
+	Data      time.Time `json:"data"`
+	IDContato int64     `json:"id_contato"`
+	Usuario   string    `json:"usuario"`
+}
+
+type Sink interface {
+	Escrever(registro RegistroExclusao) error
+	Close() error
+}
+
+type DeletionLogger struct {
+	sink Sink
+}
 
-	logEntry := fmt.Sprintf("%s - Contato ID %d excluído.", time.Now().Format("2006-01-02 15:04:05"), contactID)
-	if _, err := file.WriteString(logEntry); err != nil {
-		log.Printf("ERRO: Falha ao escrever no arquivo de log %s: %v", logFilePath, err)
+func NewDeletionLogger(sink Sink) *DeletionLogger {
+	return &DeletionLogger{sink: sink}
+}
+
+func (l *DeletionLogger) LogDeletedContact(ctx context.Context, contactID int64) {
+	registro := RegistroExclusao{
+		Data:      time.Now(),
+		IDContato: contactID,
+		Usuario:   requisicao.Autor(ctx),
+	}
+	if err := l.sink.Escrever(registro); err != nil {
+		log.Printf("ERRO: Falha ao registrar exclusao do contato %d: %v", contactID, err) // Não impede a operação principal se o log falhar
 	}
-}
\ No newline at end of file
+}
+
+func (l *DeletionLogger) Close() error {
+	return l.sink.Close()
+}
@@ -0,0 +1,71 @@
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/robitooS/backend/internal/config"
+)
+
+const (
+	SinkArquivo = "arquivo"
+	SinkStdout  = "stdout"
+	SinkSyslog  = "syslog"
+)
+
+func NewSink(cfg *config.Config) (Sink, error) {
+	switch cfg.DelLogSink {
+	case SinkArquivo:
+		sink, err := NewArquivoSink(cfg.DelLogPath, cfg.DelLogMaxBytes, cfg.DelLogMaxAge, cfg.DelLogBuffer)
+		if err != nil {
+			return nil, err
+		}
+		return sink, nil
+	case SinkStdout:
+		return NewWriterSink(os.Stdout), nil
+	case SinkSyslog:
+		sink, err := NewSyslogSink(cfg.DelLogSyslogTag)
+		if err != nil {
+			return nil, err
+		}
+		return sink, nil
+	default:
+		return nil, fmt.Errorf("logger: sink de exclusao desconhecido %q, use %s, %s ou %s", cfg.DelLogSink, SinkArquivo, SinkStdout, SinkSyslog)
+	}
+}
+
+type WriterSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func NewWriterSink(w io.Writer) *WriterSink {
+	return &WriterSink{w: w}
+}
+
+func (s *WriterSink) Escrever(registro RegistroExclusao) error {
+	linha, err := linhaJSON(registro)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.w.Write(linha)
+	return err
+}
+
+func (s *WriterSink) Close() error {
+	return nil
+}
+
+func linhaJSON(registro RegistroExclusao) ([]byte, error) {
+	linha, err := json.Marshal(registro)
+	if err != nil {
+		return nil, fmt.Errorf("logger: falha ao serializar registro de exclusao: %w", err)
+	}
+	return append(linha, '\n'), nil
+}
@@ -0,0 +1,32 @@
+//go:build !windows && !plan9
+
+package logger
+
+import (
+	"fmt"
+	"log/syslog"
+)
+
+type SyslogSink struct {
+	w *syslog.Writer
+}
+
+func NewSyslogSink(tag string) (*SyslogSink, error) {
+	w, err := syslog.New(syslog.LOG_INFO|syslog.LOG_USER, tag)
+	if err != nil {
+		return nil, fmt.Errorf("logger: falha ao conectar ao syslog: %w", err)
+	}
+	return &SyslogSink{w: w}, nil
+}
+
+func (s *SyslogSink) Escrever(registro RegistroExclusao) error {
+	linha, err := linhaJSON(registro)
+	if err != nil {
+		return err
+	}
+	return s.w.Info(string(linha[:len(linha)-1]))
+}
+
+func (s *SyslogSink) Close() error {
+	return s.w.Close()
+}
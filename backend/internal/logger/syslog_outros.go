@@ -0,0 +1,9 @@
+//go:build windows || plan9
+
+package logger
+
+import "errors"
+
+func NewSyslogSink(tag string) (Sink, error) {
+	return nil, errors.New("logger: syslog nao e suportado nesta plataforma")
+}
@@ -0,0 +1,186 @@
+package planilha
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/robitooS/backend/internal/entity"
+	"github.com/robitooS/backend/internal/errors"
+)
+
+const prefixoTelefone = "telefone"
+
+type Mapeamento struct {
+	Nome      string   `json:"nome"`
+	Idade     string   `json:"idade"`
+	Telefones []string `json:"telefones"`
+}
+
+type Linha struct {
+	Numero  int
+	Contato *entity.Contato
+	Erro    error
+}
+
+func Escrever(w io.Writer, contatos []*entity.Contato) error {
+	maxTelefones := 0
+	for _, contato := range contatos {
+		if len(contato.Telefones) > maxTelefones {
+			maxTelefones = len(contato.Telefones)
+		}
+	}
+
+	escritor := csv.NewWriter(w)
+	cabecalho := []string{"id", "nome", "idade"}
+	for i := 1; i <= maxTelefones; i++ {
+		cabecalho = append(cabecalho, fmt.Sprintf("%s_%d", prefixoTelefone, i))
+	}
+	if err := escritor.Write(cabecalho); err != nil {
+		return errors.WrapErrorf(err, "planilha: falha ao escrever cabecalho")
+	}
+
+	for _, contato := range contatos {
+		registro := make([]string, len(cabecalho))
+		registro[0] = strconv.FormatInt(contato.ID, 10)
+		registro[1] = contato.Nome
+		registro[2] = strconv.Itoa(contato.Idade)
+		for i, telefone := range contato.Telefones {
+			registro[3+i] = telefone.Numero
+		}
+		if err := escritor.Write(registro); err != nil {
+			return errors.WrapErrorf(err, "planilha: falha ao escrever contato %d", contato.ID)
+		}
+	}
+
+	escritor.Flush()
+	return escritor.Error()
+}
+
+func Ler(r io.Reader, mapeamento *Mapeamento) ([]Linha, error) {
+	leitor := csv.NewReader(r)
+	leitor.FieldsPerRecord = -1
+	leitor.TrimLeadingSpace = true
+
+	cabecalho, err := leitor.Read()
+	if err == io.EOF {
+		return nil, errors.WrapErrorf(errors.ErrInvalidInput, "planilha: arquivo CSV vazio")
+	}
+	if err != nil {
+		return nil, errors.WrapErrorf(errors.ErrInvalidInput, "planilha: falha ao ler cabecalho: %v", err)
+	}
+
+	colunas, err := resolverColunas(cabecalho, mapeamento)
+	if err != nil {
+		return nil, err
+	}
+
+	var linhas []Linha
+	for numero := 2; ; numero++ {
+		registro, err := leitor.Read()
+		if err == io.EOF {
+			return linhas, nil
+		}
+		if err != nil {
+			linhas = append(linhas, Linha{Numero: numero, Erro: errors.WrapErrorf(errors.ErrInvalidInput, "planilha: linha %d mal formatada: %v", numero, err)})
+			continue
+		}
+		if linhaVazia(registro) {
+			continue
+		}
+
+		contato, err := colunas.contato(registro)
+		if err != nil {
+			err = errors.WrapErrorf(err, "planilha: linha %d", numero)
+		}
+		linhas = append(linhas, Linha{Numero: numero, Contato: contato, Erro: err})
+	}
+}
+
+type colunas struct {
+	nome      int
+	idade     int
+	telefones []int
+}
+
+func resolverColunas(cabecalho []string, mapeamento *Mapeamento) (*colunas, error) {
+	indices := make(map[string]int, len(cabecalho))
+	for i, coluna := range cabecalho {
+		indices[normalizarColuna(coluna)] = i
+	}
+
+	if mapeamento == nil {
+		mapeamento = &Mapeamento{Nome: "nome", Idade: "idade"}
+		for _, coluna := range cabecalho {
+			if strings.HasPrefix(normalizarColuna(coluna), prefixoTelefone) {
+				mapeamento.Telefones = append(mapeamento.Telefones, coluna)
+			}
+		}
+	}
+
+	resultado := &colunas{nome: -1, idade: -1}
+	nome, ok := indices[normalizarColuna(mapeamento.Nome)]
+	if !ok {
+		return nil, errors.WrapErrorf(errors.ErrInvalidInput, "planilha: coluna de nome %q nao encontrada no cabecalho", mapeamento.Nome)
+	}
+	resultado.nome = nome
+
+	if mapeamento.Idade != "" {
+		idade, ok := indices[normalizarColuna(mapeamento.Idade)]
+		if !ok {
+			return nil, errors.WrapErrorf(errors.ErrInvalidInput, "planilha: coluna de idade %q nao encontrada no cabecalho", mapeamento.Idade)
+		}
+		resultado.idade = idade
+	}
+
+	for _, coluna := range mapeamento.Telefones {
+		indice, ok := indices[normalizarColuna(coluna)]
+		if !ok {
+			return nil, errors.WrapErrorf(errors.ErrInvalidInput, "planilha: coluna de telefone %q nao encontrada no cabecalho", coluna)
+		}
+		resultado.telefones = append(resultado.telefones, indice)
+	}
+
+	return resultado, nil
+}
+
+func (c *colunas) contato(registro []string) (*entity.Contato, error) {
+	contato := &entity.Contato{Nome: campo(registro, c.nome)}
+
+	if idade := campo(registro, c.idade); idade != "" {
+		valor, err := strconv.Atoi(idade)
+		if err != nil {
+			return nil, errors.WrapErrorf(errors.ErrInvalidInput, "idade %q invalida", idade)
+		}
+		contato.Idade = valor
+	}
+
+	for _, indice := range c.telefones {
+		if numero := campo(registro, indice); numero != "" {
+			contato.Telefones = append(contato.Telefones, entity.Telefone{Numero: numero})
+		}
+	}
+	return contato, nil
+}
+
+func campo(registro []string, indice int) string {
+	if indice < 0 || indice >= len(registro) {
+		return ""
+	}
+	return strings.TrimSpace(registro[indice])
+}
+
+func linhaVazia(registro []string) bool {
+	for _, valor := range registro {
+		if strings.TrimSpace(valor) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func normalizarColuna(coluna string) string {
+	return strings.ToLower(strings.TrimSpace(strings.TrimPrefix(coluna, "\ufeff")))
+}
@@ -0,0 +1,166 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	stdErrors "errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/robitooS/backend/internal/entity"
+	"github.com/robitooS/backend/internal/errors"
+)
+
+type AgendaPostgres struct {
+	db *sql.DB
+}
+
+func NewAgendaPostgres(db *sql.DB) *AgendaPostgres {
+	return &AgendaPostgres{db: db}
+}
+
+// consultaAgendas traz as agendas visiveis ao usuario $1 com a permissao dele sobre cada uma.
+const consultaAgendas = `SELECT a.ID, a.NOME, COALESCE(a.IDDONO, 0), a.CRIADA_EM,
+	CASE WHEN a.IDDONO = $1 THEN 'dono' ELSE ac.PERMISSAO END
+FROM Agenda a
+LEFT JOIN AgendaCompartilhamento ac ON ac.IDAGENDA = a.ID AND ac.IDUSUARIO = $1
+WHERE (a.IDDONO = $1 OR ac.IDUSUARIO IS NOT NULL)`
+
+func (r *AgendaPostgres) Create(ctx context.Context, agenda *entity.Agenda) error {
+	err := r.db.QueryRowContext(ctx, "INSERT INTO Agenda (NOME, IDDONO) VALUES ($1, $2) RETURNING ID, CRIADA_EM",
+		agenda.Nome, agenda.IDDono).Scan(&agenda.ID, &agenda.CriadaEm)
+	if err != nil {
+		return errors.WrapErrorf(err, "repositorio: falha ao inserir agenda")
+	}
+	agenda.Permissao = entity.PermissaoDono
+	return nil
+}
+
+func (r *AgendaPostgres) FindByUsuario(ctx context.Context, idUsuario int64) ([]*entity.Agenda, error) {
+	agendas, err := r.buscarAgendas(ctx, consultaAgendas+" ORDER BY a.ID", idUsuario)
+	if err != nil {
+		return nil, errors.WrapErrorf(err, "repositorio: falha ao consultar agendas do usuario %d", idUsuario)
+	}
+	return agendas, nil
+}
+
+// FindByID so encontra a agenda se o usuario for o dono ou tiver recebido um compartilhamento.
+func (r *AgendaPostgres) FindByID(ctx context.Context, id int64, idUsuario int64) (*entity.Agenda, error) {
+	agendas, err := r.buscarAgendas(ctx, consultaAgendas+" AND a.ID = $2", idUsuario, id)
+	if err != nil {
+		return nil, errors.WrapErrorf(err, "repositorio: falha ao consultar agenda %d", id)
+	}
+	if len(agendas) == 0 {
+		return nil, errors.WrapErrorf(errors.ErrNotFound, "repositorio: agenda %d nao encontrada", id)
+	}
+	return agendas[0], nil
+}
+
+func (r *AgendaPostgres) Update(ctx context.Context, agenda *entity.Agenda) error {
+	res, err := r.db.ExecContext(ctx, "UPDATE Agenda SET NOME = $1 WHERE ID = $2", agenda.Nome, agenda.ID)
+	if err != nil {
+		return errors.WrapErrorf(err, "repositorio: falha ao atualizar agenda %d", agenda.ID)
+	}
+	rowsAffected, _ := res.RowsAffected()
+	if rowsAffected == 0 {
+		return errors.WrapErrorf(errors.ErrNotFound, "repositorio: agenda %d nao encontrada", agenda.ID)
+	}
+	return nil
+}
+
+func (r *AgendaPostgres) Delete(ctx context.Context, id int64) error {
+	res, err := r.db.ExecContext(ctx, "DELETE FROM Agenda WHERE ID = $1", id)
+	if violacaoChaveEstrangeira(err) {
+		return errors.WrapErrorf(errors.ErrInvalidInput, "repositorio: agenda %d ainda possui contatos, inclusive na lixeira", id)
+	}
+	if err != nil {
+		return errors.WrapErrorf(err, "repositorio: falha ao excluir agenda %d", id)
+	}
+	rowsAffected, _ := res.RowsAffected()
+	if rowsAffected == 0 {
+		return errors.WrapErrorf(errors.ErrNotFound, "repositorio: agenda %d nao encontrada", id)
+	}
+	return nil
+}
+
+func (r *AgendaPostgres) FindCompartilhamentos(ctx context.Context, idAgenda int64) ([]entity.Compartilhamento, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT ac.IDAGENDA, ac.IDUSUARIO, u.LOGIN, ac.PERMISSAO
+		FROM AgendaCompartilhamento ac JOIN Usuario u ON u.ID = ac.IDUSUARIO
+		WHERE ac.IDAGENDA = $1 ORDER BY lower(u.LOGIN)`, idAgenda)
+	if err != nil {
+		return nil, errors.WrapErrorf(err, "repositorio: falha ao consultar compartilhamentos da agenda %d", idAgenda)
+	}
+	defer rows.Close()
+
+	compartilhamentos := []entity.Compartilhamento{}
+	for rows.Next() {
+		var compartilhamento entity.Compartilhamento
+		if err := rows.Scan(&compartilhamento.IDAgenda, &compartilhamento.IDUsuario, &compartilhamento.Login, &compartilhamento.Permissao); err != nil {
+			return nil, errors.WrapErrorf(err, "repositorio: falha ao escanear linha da consulta de compartilhamentos da agenda %d", idAgenda)
+		}
+		compartilhamentos = append(compartilhamentos, compartilhamento)
+	}
+	return compartilhamentos, rows.Err()
+}
+
+func (r *AgendaPostgres) Compartilhar(ctx context.Context, compartilhamento *entity.Compartilhamento) error {
+	err := r.db.QueryRowContext(ctx, `WITH gravado AS (
+			INSERT INTO AgendaCompartilhamento (IDAGENDA, IDUSUARIO, PERMISSAO) VALUES ($1, $2, $3)
+			ON CONFLICT (IDAGENDA, IDUSUARIO) DO UPDATE SET PERMISSAO = EXCLUDED.PERMISSAO
+			RETURNING IDUSUARIO
+		)
+		SELECT u.LOGIN FROM gravado g JOIN Usuario u ON u.ID = g.IDUSUARIO`,
+		compartilhamento.IDAgenda, compartilhamento.IDUsuario, compartilhamento.Permissao).Scan(&compartilhamento.Login)
+	if violacaoChaveEstrangeira(err) {
+		return errors.WrapErrorf(errors.ErrNotFound, "repositorio: usuario %d ou agenda %d nao encontrados", compartilhamento.IDUsuario, compartilhamento.IDAgenda)
+	}
+	if err != nil {
+		return errors.WrapErrorf(err, "repositorio: falha ao compartilhar agenda %d com o usuario %d", compartilhamento.IDAgenda, compartilhamento.IDUsuario)
+	}
+	return nil
+}
+
+func (r *AgendaPostgres) RemoverCompartilhamento(ctx context.Context, idAgenda int64, idUsuario int64) error {
+	res, err := r.db.ExecContext(ctx, "DELETE FROM AgendaCompartilhamento WHERE IDAGENDA = $1 AND IDUSUARIO = $2", idAgenda, idUsuario)
+	if err != nil {
+		return errors.WrapErrorf(err, "repositorio: falha ao remover compartilhamento da agenda %d com o usuario %d", idAgenda, idUsuario)
+	}
+	rowsAffected, _ := res.RowsAffected()
+	if rowsAffected == 0 {
+		return errors.WrapErrorf(errors.ErrNotFound, "repositorio: agenda %d nao esta compartilhada com o usuario %d", idAgenda, idUsuario)
+	}
+	return nil
+}
+
+// AdotarSemDono entrega ao administrador mais antigo as agendas criadas pela migracao antes de existir qualquer usuario.
+func (r *AgendaPostgres) AdotarSemDono(ctx context.Context) (int64, error) {
+	res, err := r.db.ExecContext(ctx, `UPDATE Agenda SET IDDONO = admin.ID
+		FROM (SELECT MIN(ID) AS ID FROM Usuario WHERE PAPEL = 'admin') admin
+		WHERE Agenda.IDDONO IS NULL AND admin.ID IS NOT NULL`)
+	if err != nil {
+		return 0, errors.WrapErrorf(err, "repositorio: falha ao atribuir dono as agendas sem dono")
+	}
+	return res.RowsAffected()
+}
+
+func (r *AgendaPostgres) buscarAgendas(ctx context.Context, query string, args ...interface{}) ([]*entity.Agenda, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	agendas := []*entity.Agenda{}
+	for rows.Next() {
+		var agenda entity.Agenda
+		if err := rows.Scan(&agenda.ID, &agenda.Nome, &agenda.IDDono, &agenda.CriadaEm, &agenda.Permissao); err != nil {
+			return nil, err
+		}
+		agendas = append(agendas, &agenda)
+	}
+	return agendas, rows.Err()
+}
+
+func violacaoChaveEstrangeira(err error) bool {
+	var pgErr *pgconn.PgError
+	return stdErrors.As(err, &pgErr) && pgErr.Code == "23503"
+}
@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/robitooS/backend/internal/entity"
+	"github.com/robitooS/backend/internal/errors"
+	"github.com/robitooS/backend/internal/requisicao"
+)
+
+type AuditoriaPostgres struct {
+	db *sql.DB
+}
+
+func NewAuditoriaPostgres(db *sql.DB) *AuditoriaPostgres {
+	return &AuditoriaPostgres{db: db}
+}
+
+func registrarAuditoria(ctx context.Context, tx *sql.Tx, operacao entity.OperacaoAuditoria, idContato int64, antes, depois *entity.Contato) error {
+	agenda, err := agendaDoContexto(ctx)
+	if err != nil {
+		return err
+	}
+	antesJSON, err := instantaneo(antes)
+	if err != nil {
+		return err
+	}
+	depoisJSON, err := instantaneo(depois)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "INSERT INTO Auditoria (IDAGENDA, USUARIO, OPERACAO, IDCONTATO, ANTES, DEPOIS) VALUES ($1, $2, $3, $4, $5::jsonb, $6::jsonb)",
+		agenda, requisicao.Autor(ctx), string(operacao), idContato, antesJSON, depoisJSON)
+	if err != nil {
+		return errors.WrapErrorf(err, "repositorio: falha ao registrar auditoria de %s do contato %d", operacao, idContato)
+	}
+	return nil
+}
+
+func instantaneo(contato *entity.Contato) (interface{}, error) {
+	if contato == nil {
+		return nil, nil
+	}
+	copia := *contato
+	copia.Relevancia = 0
+	dados, err := json.Marshal(copia)
+	if err != nil {
+		return nil, errors.WrapErrorf(err, "repositorio: falha ao serializar contato %d para auditoria", contato.ID)
+	}
+	return string(dados), nil
+}
+
+const colunasAuditoria = "ID, DATA, USUARIO, OPERACAO, IDCONTATO, ANTES, DEPOIS"
+
+func (r *AuditoriaPostgres) FindWithFilters(ctx context.Context, filtro entity.FiltroAuditoria, pag entity.Paginacao) (*entity.PaginaAuditoria, error) {
+	agenda, err := agendaDoContexto(ctx)
+	if err != nil {
+		return nil, err
+	}
+	where, args := filtrosAuditoria(agenda, filtro)
+	argsPagina := append([]interface{}{}, args...)
+
+	query := "SELECT " + colunasAuditoria + " FROM Auditoria WHERE " + where
+	if pag.Cursor != "" {
+		id, err := strconv.ParseInt(pag.Cursor, 10, 64)
+		if err != nil {
+			return nil, errors.WrapErrorf(errors.ErrInvalidInput, "repositorio: cursor de paginacao invalido %q", pag.Cursor)
+		}
+		argsPagina = append(argsPagina, id)
+		query += fmt.Sprintf(" AND ID < $%d", len(argsPagina))
+	}
+	argsPagina = append(argsPagina, pag.Limite+1)
+	query += fmt.Sprintf(" ORDER BY ID DESC LIMIT $%d", len(argsPagina))
+
+	rows, err := r.db.QueryContext(ctx, query, argsPagina...)
+	if err != nil {
+		return nil, errors.WrapErrorf(err, "repositorio: falha ao consultar auditoria")
+	}
+	defer rows.Close()
+
+	var registros []*entity.Auditoria
+	for rows.Next() {
+		registro := &entity.Auditoria{}
+		var operacao string
+		var antes, depois []byte
+		if err := rows.Scan(&registro.ID, &registro.Data, &registro.Usuario, &operacao, &registro.IDContato, &antes, &depois); err != nil {
+			return nil, errors.WrapErrorf(err, "repositorio: falha ao escanear linha da consulta de auditoria")
+		}
+		registro.Operacao = entity.OperacaoAuditoria(operacao)
+		registro.Antes = jsonOuNulo(antes)
+		registro.Depois = jsonOuNulo(depois)
+		registros = append(registros, registro)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.WrapErrorf(err, "repositorio: falha ao consultar auditoria")
+	}
+
+	pagina := &entity.PaginaAuditoria{Registros: registros}
+	if len(registros) > pag.Limite {
+		pagina.Registros = registros[:pag.Limite]
+		pagina.ProximoCursor = strconv.FormatInt(pagina.Registros[pag.Limite-1].ID, 10)
+	}
+
+	if pag.IncluirTotal {
+		var total int64
+		if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM Auditoria WHERE "+where, args...).Scan(&total); err != nil {
+			return nil, errors.WrapErrorf(err, "repositorio: falha ao contar registros de auditoria")
+		}
+		pagina.Total = &total
+	}
+
+	return pagina, nil
+}
+
+func filtrosAuditoria(agenda int64, filtro entity.FiltroAuditoria) (string, []interface{}) {
+	where := "IDAGENDA = $1"
+	args := []interface{}{agenda}
+
+	if filtro.IDContato != 0 {
+		args = append(args, filtro.IDContato)
+		where += fmt.Sprintf(" AND IDCONTATO = $%d", len(args))
+	}
+	if filtro.Operacao != "" {
+		args = append(args, string(filtro.Operacao))
+		where += fmt.Sprintf(" AND OPERACAO = $%d", len(args))
+	}
+	if filtro.De != nil {
+		args = append(args, *filtro.De)
+		where += fmt.Sprintf(" AND DATA >= $%d", len(args))
+	}
+	if filtro.Ate != nil {
+		args = append(args, *filtro.Ate)
+		where += fmt.Sprintf(" AND DATA <= $%d", len(args))
+	}
+
+	return where, args
+}
+
+func jsonOuNulo(dados []byte) json.RawMessage {
+	if len(dados) == 0 {
+		return json.RawMessage("null")
+	}
+	return json.RawMessage(dados)
+}
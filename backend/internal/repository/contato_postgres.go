@@ -4,11 +4,13 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"log"
+	"strconv"
+	"strings"
+	"time"
 
-	"github.com/lib/pq"
 	"github.com/robitooS/backend/internal/entity"
 	"github.com/robitooS/backend/internal/errors"
+	"github.com/robitooS/backend/internal/requisicao"
 )
 
 type ContatoPostgres struct {
@@ -26,196 +28,491 @@ func (r *ContatoPostgres) Create(ctx context.Context, contato *entity.Contato) e
 	}
 	defer tx.Rollback()
 
-	_, err = tx.ExecContext(ctx, "INSERT INTO Contato (ID, NOME, IDADE) VALUES ($1, $2, $3)",
-		contato.ID, contato.Nome, contato.Idade)
+	if err := inserirContato(ctx, tx, contato); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *ContatoPostgres) CreateLote(ctx context.Context, contatos []*entity.Contato) (int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
-		log.Printf("DEBUG REPO CREATE: Erro na insercao de Contato: %v, tipo: %T", err, err) 
-		if pqErr, ok := err.(*pq.Error); ok {
-			if pqErr.Code == "23505" { // 23505 é o código para unique_violation
-				return errors.WrapErrorf(errors.ErrAlreadyExists, "repositorio: contato com ID %d ja existe", contato.ID)
-			}
+		return -1, errors.WrapErrorf(err, "repositorio: falha ao iniciar transacao para criar lote de contatos")
+	}
+	defer tx.Rollback()
+
+	for i, contato := range contatos {
+		if err := inserirContato(ctx, tx, contato); err != nil {
+			return i, err
 		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return -1, errors.WrapErrorf(err, "repositorio: falha ao confirmar lote de contatos")
+	}
+	return -1, nil
+}
+
+func inserirContato(ctx context.Context, tx *sql.Tx, contato *entity.Contato) error {
+	agenda, err := agendaDoContexto(ctx)
+	if err != nil {
+		return err
+	}
+	contato.IDAgenda = agenda
+
+	idade, nascimento := colunasIdade(contato)
+	err = tx.QueryRowContext(ctx, "INSERT INTO Contato (IDAGENDA, NOME, IDADE, DATA_NASCIMENTO) VALUES ($1, $2, $3, $4) RETURNING ID, VERSAO",
+		contato.IDAgenda, contato.Nome, idade, nascimento).Scan(&contato.ID, &contato.Versao)
+	if err != nil {
 		return errors.WrapErrorf(err, "repositorio: falha ao inserir contato")
 	}
 
-	for _, telefone := range contato.Telefones {
-		_, err := tx.ExecContext(ctx, "INSERT INTO Telefone (IDCONTATO, ID, NUMERO) VALUES ($1, $2, $3)",
-			telefone.IDContato, telefone.ID, telefone.Numero)
+	principal := indicePrincipal(contato.Telefones)
+	for i := range contato.Telefones {
+		telefone := &contato.Telefones[i]
+		telefone.IDContato = contato.ID
+		telefone.ID = int64(i + 1)
+		telefone.Principal = i == principal
+		_, err := tx.ExecContext(ctx, "INSERT INTO Telefone ("+colunasTelefone+") VALUES ($1, $2, $3, $4, $5, $6, $7)",
+			telefone.IDContato, telefone.ID, telefone.Numero, telefone.NumeroE164, telefone.Tipo, telefone.Rotulo, telefone.Principal)
 		if err != nil {
 			return errors.WrapErrorf(err, "repositorio: falha ao inserir telefone para o contato %d", contato.ID)
 		}
 	}
 
-	return tx.Commit()
+	if err := inserirEmails(ctx, tx, contato); err != nil {
+		return err
+	}
+	if err := inserirEnderecos(ctx, tx, contato); err != nil {
+		return err
+	}
+
+	if err := registrarRevisao(ctx, tx, contato); err != nil {
+		return err
+	}
+	return registrarAuditoria(ctx, tx, entity.OperacaoCriacao, contato.ID, nil, contato)
+}
+
+// colunasIdade grava a idade informada apenas para contatos sem data de nascimento; com a data, a idade e calculada na leitura.
+func colunasIdade(contato *entity.Contato) (interface{}, interface{}) {
+	if contato.DataNascimento == nil {
+		return contato.Idade, nil
+	}
+	return nil, contato.DataNascimento.Format(entity.FormatoData)
 }
 
+// agendaDoContexto devolve a agenda a que a requisicao esta restrita; sem ela nenhuma consulta de contatos e feita.
+func agendaDoContexto(ctx context.Context) (int64, error) {
+	agenda, ok := requisicao.Agenda(ctx)
+	if !ok {
+		return 0, errors.WrapErrorf(errors.ErrInternal, "repositorio: requisicao sem agenda definida")
+	}
+	return agenda, nil
+}
+
+const colunasContato = "c.ID, c.IDAGENDA, c.NOME, c.IDADE, c.VERSAO, c.DELETED_AT, c.DATA_NASCIMENTO"
+
 func (r *ContatoPostgres) FindAll(ctx context.Context) ([]*entity.Contato, error) {
-	return r.FindWithFilters(ctx, "", "")
+	agenda, err := agendaDoContexto(ctx)
+	if err != nil {
+		return nil, err
+	}
+	contatos, err := r.buscarContatos(ctx, "SELECT "+colunasContato+" FROM Contato c WHERE c.IDAGENDA = $1 AND c.DELETED_AT IS NULL ORDER BY c.ID", agenda)
+	if err != nil {
+		return nil, errors.WrapErrorf(err, "repositorio: falha ao consultar todos os contatos")
+	}
+	return contatos, nil
 }
 
-func (r *ContatoPostgres) FindWithFilters(ctx context.Context, nome string, numero string) ([]*entity.Contato, error) {
-	query := `
-		SELECT c.ID, c.NOME, c.IDADE, t.IDCONTATO, t.ID, t.NUMERO 
-		FROM Contato c 
-		LEFT JOIN Telefone t ON c.ID = t.IDCONTATO 
-		WHERE 1=1
-	`
-	var args []interface{}
-	argCount := 1
+const nomeNormalizado = "lower(f_unaccent(c.NOME))"
 
-	if nome != "" {
-		query += fmt.Sprintf(" AND c.NOME ILIKE $%d", argCount)
-		args = append(args, "%"+nome+"%")
-		argCount++
+func (r *ContatoPostgres) FindWithFilters(ctx context.Context, filtro entity.FiltroContato, pag entity.Paginacao) (*entity.PaginaContatos, error) {
+	agenda, err := agendaDoContexto(ctx)
+	if err != nil {
+		return nil, err
 	}
+	where, args := filtrosContato(agenda, filtro)
+	argsPagina := append([]interface{}{}, args...)
+
+	porRelevancia := filtro.Ordem == entity.OrdenarPorRelevancia && filtro.Nome != ""
+	colunas := colunasContato
+	ordem := " ORDER BY c.ID"
+	relevancia := ""
+	if porRelevancia {
+		argsPagina = append(argsPagina, filtro.Nome)
+		relevancia = fmt.Sprintf("GREATEST(similarity(%[1]s, lower(f_unaccent($%[2]d))), word_similarity(lower(f_unaccent($%[2]d)), %[1]s))", nomeNormalizado, len(argsPagina))
+		colunas += ", " + relevancia
+		ordem = " ORDER BY " + relevancia + " DESC, c.ID"
+	}
+
+	query := "SELECT " + colunas + " FROM Contato c WHERE " + where
 
-	if numero != "" {
-		query += fmt.Sprintf(" AND EXISTS (SELECT 1 FROM Telefone t2 WHERE t2.IDCONTATO = c.ID AND t2.NUMERO LIKE $%d)", argCount)
-		args = append(args, "%"+numero+"%")
-		argCount++
+	if pag.Cursor != "" {
+		score, id, err := decodificarCursor(pag.Cursor, porRelevancia)
+		if err != nil {
+			return nil, err
+		}
+		if porRelevancia {
+			argsPagina = append(argsPagina, score, id)
+			query += fmt.Sprintf(" AND (%[1]s < $%[2]d::real OR (%[1]s = $%[2]d::real AND c.ID > $%[3]d))", relevancia, len(argsPagina)-1, len(argsPagina))
+		} else {
+			argsPagina = append(argsPagina, id)
+			query += fmt.Sprintf(" AND c.ID > $%d", len(argsPagina))
+		}
 	}
 
-	query += " ORDER BY c.ID, t.ID"
+	argsPagina = append(argsPagina, pag.Limite+1)
+	query += ordem + fmt.Sprintf(" LIMIT $%d", len(argsPagina))
 
-	rows, err := r.db.QueryContext(ctx, query, args...)
+	contatos, err := consultarContatos(ctx, r.db, porRelevancia, query, argsPagina...)
 	if err != nil {
 		return nil, errors.WrapErrorf(err, "repositorio: falha ao consultar contatos com filtros")
 	}
-	defer rows.Close()
 
-	contactsMap := make(map[int64]*entity.Contato)
-	var contacts []*entity.Contato
+	pagina := &entity.PaginaContatos{Contatos: contatos}
+	if len(contatos) > pag.Limite {
+		pagina.Contatos = contatos[:pag.Limite]
+		pagina.ProximoCursor = codificarCursor(pagina.Contatos[pag.Limite-1], porRelevancia)
+	}
 
-	for rows.Next() {
-		var (
-			contatoID         sql.NullInt64
-			contatoNome       sql.NullString
-			contatoIdade      sql.NullInt32
-			telefoneIDContato sql.NullInt64
-			telefoneID        sql.NullInt64
-			telefoneNumero    sql.NullString
-		)
-		err := rows.Scan(&contatoID, &contatoNome, &contatoIdade, &telefoneIDContato, &telefoneID, &telefoneNumero)
+	if pag.IncluirTotal {
+		var total int64
+		err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM Contato c WHERE "+where, args...).Scan(&total)
 		if err != nil {
-			return nil, errors.WrapErrorf(err, "repositorio: falha ao escanear linha da consulta de contatos com filtros")
+			return nil, errors.WrapErrorf(err, "repositorio: falha ao contar contatos com filtros")
 		}
+		pagina.Total = &total
+	}
+
+	return pagina, nil
+}
+
+func codificarCursor(ultimo *entity.Contato, porRelevancia bool) string {
+	id := strconv.FormatInt(ultimo.ID, 10)
+	if porRelevancia {
+		return strconv.FormatFloat(ultimo.Relevancia, 'g', -1, 64) + ":" + id
+	}
+	return id
+}
 
-		if _, ok := contactsMap[contatoID.Int64]; !ok {
-			contato := &entity.Contato{
-				ID:    contatoID.Int64,
-				Nome:  contatoNome.String,
-				Idade: int(contatoIdade.Int32),
-			}
-			contactsMap[contatoID.Int64] = contato
-			contacts = append(contacts, contato)
+func decodificarCursor(cursor string, porRelevancia bool) (float64, int64, error) {
+	invalido := errors.WrapErrorf(errors.ErrInvalidInput, "repositorio: cursor de paginacao invalido %q", cursor)
+
+	var score float64
+	idTexto := cursor
+	if porRelevancia {
+		scoreTexto, resto, ok := strings.Cut(cursor, ":")
+		if !ok {
+			return 0, 0, invalido
+		}
+		valor, err := strconv.ParseFloat(scoreTexto, 64)
+		if err != nil {
+			return 0, 0, invalido
 		}
+		score, idTexto = valor, resto
+	}
+
+	id, err := strconv.ParseInt(idTexto, 10, 64)
+	if err != nil {
+		return 0, 0, invalido
+	}
+	return score, id, nil
+}
 
-		if telefoneID.Valid {
-			contactsMap[contatoID.Int64].Telefones = append(contactsMap[contatoID.Int64].Telefones, entity.Telefone{
-				IDContato: telefoneIDContato.Int64,
-				ID:        telefoneID.Int64,
-				Numero:    telefoneNumero.String,
-			})
+func filtrosContato(agenda int64, filtro entity.FiltroContato) (string, []interface{}) {
+	where := "c.IDAGENDA = $1 AND c.DELETED_AT IS NULL"
+	if filtro.Excluidos {
+		where = "c.IDAGENDA = $1 AND c.DELETED_AT IS NOT NULL"
+	}
+	args := []interface{}{agenda}
+
+	if filtro.Nome != "" {
+		args = append(args, escaparLike(filtro.Nome))
+		contem := fmt.Sprintf(`%s LIKE '%%' || lower(f_unaccent($%d)) || '%%' ESCAPE '\'`, nomeNormalizado, len(args))
+		if filtro.Busca == entity.BuscaAproximada {
+			args = append(args, filtro.Nome)
+			where += fmt.Sprintf(" AND (%[1]s OR %[2]s %% lower(f_unaccent($%[3]d)) OR lower(f_unaccent($%[3]d)) <%% %[2]s)", contem, nomeNormalizado, len(args))
+		} else {
+			where += " AND " + contem
 		}
 	}
-	return contacts, nil
+
+	if filtro.Numero != "" {
+		args = append(args, "%"+filtro.Numero+"%")
+		where += fmt.Sprintf(" AND EXISTS (SELECT 1 FROM Telefone t2 WHERE t2.IDCONTATO = c.ID AND t2.NUMERO_E164 LIKE $%d)", len(args))
+	}
+
+	if filtro.Tipo != "" {
+		args = append(args, string(filtro.Tipo))
+		where += fmt.Sprintf(" AND EXISTS (SELECT 1 FROM Telefone t3 WHERE t3.IDCONTATO = c.ID AND t3.TIPO = $%d)", len(args))
+	}
+
+	if filtro.Email != "" {
+		args = append(args, escaparLike(filtro.Email))
+		where += fmt.Sprintf(` AND EXISTS (SELECT 1 FROM Email e2 WHERE e2.IDCONTATO = c.ID AND lower(e2.ENDERECO) LIKE '%%' || lower($%d) || '%%' ESCAPE '\')`, len(args))
+	}
+
+	if filtro.Cidade != "" || filtro.UF != "" {
+		condicao := ""
+		if filtro.Cidade != "" {
+			args = append(args, filtro.Cidade)
+			condicao += fmt.Sprintf(" AND lower(f_unaccent(en.CIDADE)) = lower(f_unaccent($%d))", len(args))
+		}
+		if filtro.UF != "" {
+			args = append(args, filtro.UF)
+			condicao += fmt.Sprintf(" AND en.UF = $%d", len(args))
+		}
+		where += " AND EXISTS (SELECT 1 FROM Endereco en WHERE en.IDCONTATO = c.ID" + condicao + ")"
+	}
+
+	if filtro.Grupo != 0 {
+		args = append(args, filtro.Grupo)
+		where += fmt.Sprintf(" AND EXISTS (SELECT 1 FROM ContatoGrupo cg WHERE cg.IDCONTATO = c.ID AND cg.IDGRUPO = $%d)", len(args))
+	}
+
+	return where, args
+}
+
+var escapeLike = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+func escaparLike(texto string) string {
+	return escapeLike.Replace(texto)
 }
 
 func (r *ContatoPostgres) FindByID(ctx context.Context, id int64) (*entity.Contato, error) {
-	contato := &entity.Contato{}
-	var (
-		contatoID         sql.NullInt64
-		contatoNome       sql.NullString
-		contatoIdade      sql.NullInt32
-		telefoneIDContato sql.NullInt64
-		telefoneID        sql.NullInt64
-		telefoneNumero    sql.NullString
-	)
-
-	rows, err := r.db.QueryContext(ctx, "SELECT c.ID, c.NOME, c.IDADE, t.IDCONTATO, t.ID, t.NUMERO FROM Contato c LEFT JOIN Telefone t ON c.ID = t.IDCONTATO WHERE c.ID = $1 ORDER BY t.ID", id)
+	agenda, err := agendaDoContexto(ctx)
+	if err != nil {
+		return nil, err
+	}
+	contatos, err := r.buscarContatos(ctx, "SELECT "+colunasContato+" FROM Contato c WHERE c.ID = $1 AND c.IDAGENDA = $2 AND c.DELETED_AT IS NULL", id, agenda)
 	if err != nil {
 		return nil, errors.WrapErrorf(err, "repositorio: falha ao consultar contato por ID %d", id)
 	}
+	if len(contatos) == 0 {
+		return nil, errors.ErrNotFound
+	}
+	return contatos[0], nil
+}
+
+func (r *ContatoPostgres) FindAniversariantes(ctx context.Context, diasDoAno []int64) ([]*entity.Contato, error) {
+	agenda, err := agendaDoContexto(ctx)
+	if err != nil {
+		return nil, err
+	}
+	contatos, err := r.buscarContatos(ctx, "SELECT "+colunasContato+" FROM Contato c WHERE c.IDAGENDA = $2 AND c.DELETED_AT IS NULL AND c.DATA_NASCIMENTO IS NOT NULL "+
+		"AND (EXTRACT(MONTH FROM c.DATA_NASCIMENTO) * 100 + EXTRACT(DAY FROM c.DATA_NASCIMENTO)) = ANY($1) ORDER BY c.ID", diasDoAno, agenda)
+	if err != nil {
+		return nil, errors.WrapErrorf(err, "repositorio: falha ao consultar aniversariantes")
+	}
+	return contatos, nil
+}
+
+type consultor interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+func (r *ContatoPostgres) buscarContatos(ctx context.Context, query string, args ...interface{}) ([]*entity.Contato, error) {
+	return consultarContatos(ctx, r.db, false, query, args...)
+}
+
+func carregarContato(ctx context.Context, tx *sql.Tx, id int64) (*entity.Contato, error) {
+	agenda, err := agendaDoContexto(ctx)
+	if err != nil {
+		return nil, err
+	}
+	contatos, err := consultarContatos(ctx, tx, false, "SELECT "+colunasContato+" FROM Contato c WHERE c.ID = $1 AND c.IDAGENDA = $2 FOR UPDATE", id, agenda)
+	if err != nil {
+		return nil, errors.WrapErrorf(err, "repositorio: falha ao carregar contato %d", id)
+	}
+	if len(contatos) == 0 {
+		return nil, nil
+	}
+	return contatos[0], nil
+}
+
+func consultarContatos(ctx context.Context, q consultor, comRelevancia bool, query string, args ...interface{}) ([]*entity.Contato, error) {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
 	defer rows.Close()
 
-	found := false
+	var contatos []*entity.Contato
 	for rows.Next() {
-		err := rows.Scan(&contatoID, &contatoNome, &contatoIdade, &telefoneIDContato, &telefoneID, &telefoneNumero)
-		if err != nil {
-			return nil, errors.WrapErrorf(err, "repositorio: falha ao escanear linha da consulta de contato por ID %d", id)
+		contato := &entity.Contato{}
+		var idade sql.NullInt64
+		var nascimento *time.Time
+		destinos := []interface{}{&contato.ID, &contato.IDAgenda, &contato.Nome, &idade, &contato.Versao, &contato.DeletedAt, &nascimento}
+		if comRelevancia {
+			destinos = append(destinos, &contato.Relevancia)
 		}
-
-		if !found {
-			contato.ID = contatoID.Int64
-			contato.Nome = contatoNome.String
-			contato.Idade = int(contatoIdade.Int32)
-			found = true
+		if err := rows.Scan(destinos...); err != nil {
+			return nil, errors.WrapErrorf(err, "repositorio: falha ao escanear linha da consulta de contatos")
 		}
-
-		if telefoneID.Valid {
-			contato.Telefones = append(contato.Telefones, entity.Telefone{
-				IDContato: telefoneIDContato.Int64,
-				ID:        telefoneID.Int64,
-				Numero:    telefoneNumero.String,
-			})
+		contato.Idade = int(idade.Int64)
+		if nascimento != nil {
+			data := entity.NovaData(*nascimento)
+			contato.DataNascimento = &data
+			contato.Idade = entity.IdadeEm(data, time.Now())
 		}
+		contatos = append(contatos, contato)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
 	}
+	rows.Close()
 
-	if !found {
-		return nil, errors.ErrNotFound
+	if err := carregarTelefones(ctx, q, contatos); err != nil {
+		return nil, err
+	}
+	if err := carregarEmails(ctx, q, contatos); err != nil {
+		return nil, err
+	}
+	if err := carregarEnderecos(ctx, q, contatos); err != nil {
+		return nil, err
 	}
+	return contatos, nil
+}
 
-	return contato, nil
+func carregarTelefones(ctx context.Context, q consultor, contatos []*entity.Contato) error {
+	if len(contatos) == 0 {
+		return nil
+	}
+
+	porID := make(map[int64]*entity.Contato, len(contatos))
+	ids := make([]int64, 0, len(contatos))
+	for _, contato := range contatos {
+		porID[contato.ID] = contato
+		ids = append(ids, contato.ID)
+	}
+
+	rows, err := q.QueryContext(ctx, "SELECT "+colunasTelefone+" FROM Telefone WHERE IDCONTATO = ANY($1) ORDER BY IDCONTATO, ID", ids)
+	if err != nil {
+		return errors.WrapErrorf(err, "repositorio: falha ao consultar telefones dos contatos")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var telefone entity.Telefone
+		if err := rows.Scan(&telefone.IDContato, &telefone.ID, &telefone.Numero, &telefone.NumeroE164, &telefone.Tipo, &telefone.Rotulo, &telefone.Principal); err != nil {
+			return errors.WrapErrorf(err, "repositorio: falha ao escanear linha da consulta de telefones")
+		}
+		contato := porID[telefone.IDContato]
+		contato.Telefones = append(contato.Telefones, telefone)
+	}
+	return rows.Err()
 }
 
-func (r *ContatoPostgres) Update(ctx context.Context, contato *entity.Contato) error {
+func (r *ContatoPostgres) Update(ctx context.Context, contato *entity.Contato) (entity.AlteracoesTelefones, error) {
+	var alteracoes entity.AlteracoesTelefones
+
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
-		return errors.WrapErrorf(err, "repositorio: falha ao iniciar transacao para atualizar contato %d", contato.ID)
+		return alteracoes, errors.WrapErrorf(err, "repositorio: falha ao iniciar transacao para atualizar contato %d", contato.ID)
 	}
 	defer tx.Rollback()
 
-	res, err := tx.ExecContext(ctx, "UPDATE Contato SET NOME = $1, IDADE = $2 WHERE ID = $3",
-		contato.Nome, contato.Idade, contato.ID)
+	antes, err := carregarContato(ctx, tx, contato.ID)
 	if err != nil {
-		return errors.WrapErrorf(err, "repositorio: falha ao atualizar contato %d", contato.ID)
+		return alteracoes, err
 	}
-	rowsAffected, _ := res.RowsAffected()
-	if rowsAffected == 0 {
-		return errors.ErrNotFound
+	if antes == nil {
+		return alteracoes, errors.ErrNotFound
 	}
 
-	_, err = tx.ExecContext(ctx, "DELETE FROM Telefone WHERE IDCONTATO = $1", contato.ID)
+	versaoEsperada := contato.Versao
+	idade, nascimento := colunasIdade(contato)
+	err = tx.QueryRowContext(ctx, "UPDATE Contato SET NOME = $1, IDADE = $2, DATA_NASCIMENTO = $3, VERSAO = VERSAO + 1 WHERE ID = $4 AND DELETED_AT IS NULL AND ($5::bigint = 0 OR VERSAO = $5) RETURNING VERSAO",
+		contato.Nome, idade, nascimento, contato.ID, versaoEsperada).Scan(&contato.Versao)
+	if err == sql.ErrNoRows {
+		return alteracoes, r.conflitoOuNaoEncontrado(ctx, tx, contato.ID, versaoEsperada)
+	}
 	if err != nil {
-		return errors.WrapErrorf(err, "repositorio: falha ao deletar telefones do contato %d antes da atualizacao", contato.ID)
+		return alteracoes, errors.WrapErrorf(err, "repositorio: falha ao atualizar contato %d", contato.ID)
 	}
 
-	for _, telefone := range contato.Telefones {
-		_, err := tx.ExecContext(ctx, "INSERT INTO Telefone (IDCONTATO, ID, NUMERO) VALUES ($1, $2, $3)",
-			contato.ID, telefone.ID, telefone.Numero)
-		if err != nil {
-			return errors.WrapErrorf(err, "repositorio: falha ao inserir telefone %d para o contato %d durante a atualizacao", telefone.ID, contato.ID)
-		}
+	alteracoes, err = sincronizarTelefones(ctx, tx, contato)
+	if err != nil {
+		return alteracoes, err
+	}
+	if err := sincronizarEmails(ctx, tx, contato); err != nil {
+		return alteracoes, err
+	}
+	if err := sincronizarEnderecos(ctx, tx, contato); err != nil {
+		return alteracoes, err
 	}
 
-	return tx.Commit()
+	depois, err := carregarContato(ctx, tx, contato.ID)
+	if err != nil {
+		return alteracoes, err
+	}
+	if err := registrarRevisao(ctx, tx, depois); err != nil {
+		return alteracoes, err
+	}
+	if err := registrarAuditoria(ctx, tx, entity.OperacaoAtualizacao, contato.ID, antes, depois); err != nil {
+		return alteracoes, err
+	}
+
+	return alteracoes, tx.Commit()
+}
+
+func (r *ContatoPostgres) conflitoOuNaoEncontrado(ctx context.Context, tx *sql.Tx, id int64, versaoEsperada int64) error {
+	var versaoAtual int64
+	err := tx.QueryRowContext(ctx, "SELECT VERSAO FROM Contato WHERE ID = $1 AND DELETED_AT IS NULL", id).Scan(&versaoAtual)
+	if err == sql.ErrNoRows {
+		return errors.ErrNotFound
+	}
+	if err != nil {
+		return errors.WrapErrorf(err, "repositorio: falha ao consultar versao do contato %d", id)
+	}
+	return errors.WrapErrorf(errors.ErrVersionConflict, "repositorio: contato %d esta na versao %d, esperada %d", id, versaoAtual, versaoEsperada)
 }
 
+// Delete apenas envia o contato para a lixeira; as fotos continuam no armazenamento ate o Purge.
 func (r *ContatoPostgres) Delete(ctx context.Context, id int64) error {
+	return r.alterarContato(ctx, id, entity.OperacaoExclusao, "UPDATE Contato SET DELETED_AT = now() WHERE ID = $1 AND DELETED_AT IS NULL")
+}
+
+func (r *ContatoPostgres) Restore(ctx context.Context, id int64) error {
+	return r.alterarContato(ctx, id, entity.OperacaoRestauracao, "UPDATE Contato SET DELETED_AT = NULL WHERE ID = $1 AND DELETED_AT IS NOT NULL")
+}
+
+func (r *ContatoPostgres) Purge(ctx context.Context, id int64) error {
+	return r.alterarContato(ctx, id, entity.OperacaoExpurgo, "DELETE FROM Contato WHERE ID = $1 AND DELETED_AT IS NOT NULL")
+}
+
+func (r *ContatoPostgres) alterarContato(ctx context.Context, id int64, operacao entity.OperacaoAuditoria, query string) error {
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
-		return errors.WrapErrorf(err, "repositorio: falha ao iniciar transacao para deletar contato %d", id)
+		return errors.WrapErrorf(err, "repositorio: falha ao iniciar transacao para %s do contato %d", operacao, id)
 	}
 	defer tx.Rollback()
 
-	res, err := tx.ExecContext(ctx, "DELETE FROM Contato WHERE ID = $1", id)
+	antes, err := carregarContato(ctx, tx, id)
 	if err != nil {
-		return errors.WrapErrorf(err, "repositorio: falha ao deletar contato %d", id)
+		return err
+	}
+	if antes == nil {
+		return errors.ErrNotFound
+	}
+
+	res, err := tx.ExecContext(ctx, query, id)
+	if err != nil {
+		return errors.WrapErrorf(err, "repositorio: falha na %s do contato %d", operacao, id)
 	}
 	rowsAffected, _ := res.RowsAffected()
 	if rowsAffected == 0 {
 		return errors.ErrNotFound
 	}
 
+	depois, err := carregarContato(ctx, tx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := registrarAuditoria(ctx, tx, operacao, id, antes, depois); err != nil {
+		return err
+	}
 	return tx.Commit()
 }
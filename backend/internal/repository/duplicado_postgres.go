@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/robitooS/backend/internal/entity"
+	"github.com/robitooS/backend/internal/errors"
+)
+
+const consultaParesDuplicados = `
+SELECT p.a, p.b, bool_or(p.telefone), similarity(lower(f_unaccent(c1.NOME)), lower(f_unaccent(c2.NOME)))
+FROM (
+	SELECT t1.IDCONTATO AS a, t2.IDCONTATO AS b, TRUE AS telefone
+	FROM Telefone t1
+	JOIN Telefone t2 ON t2.NUMERO_E164 = t1.NUMERO_E164 AND t2.IDCONTATO > t1.IDCONTATO
+	UNION ALL
+	SELECT c1.ID, c2.ID, FALSE
+	FROM Contato c1
+	JOIN Contato c2 ON c2.ID > c1.ID AND c2.IDAGENDA = c1.IDAGENDA AND lower(f_unaccent(c1.NOME)) % lower(f_unaccent(c2.NOME))
+	WHERE c1.IDAGENDA = $1 AND c1.DELETED_AT IS NULL AND c2.DELETED_AT IS NULL
+) p
+JOIN Contato c1 ON c1.ID = p.a AND c1.IDAGENDA = $1 AND c1.DELETED_AT IS NULL
+JOIN Contato c2 ON c2.ID = p.b AND c2.IDAGENDA = $1 AND c2.DELETED_AT IS NULL
+GROUP BY p.a, p.b, c1.NOME, c2.NOME
+ORDER BY p.a, p.b`
+
+func (r *ContatoPostgres) FindParesDuplicados(ctx context.Context) ([]entity.ParDuplicado, error) {
+	agenda, err := agendaDoContexto(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.db.QueryContext(ctx, consultaParesDuplicados, agenda)
+	if err != nil {
+		return nil, errors.WrapErrorf(err, "repositorio: falha ao consultar contatos duplicados")
+	}
+	defer rows.Close()
+
+	var pares []entity.ParDuplicado
+	for rows.Next() {
+		var par entity.ParDuplicado
+		if err := rows.Scan(&par.IDA, &par.IDB, &par.MesmoTelefone, &par.Similaridade); err != nil {
+			return nil, errors.WrapErrorf(err, "repositorio: falha ao escanear linha da consulta de contatos duplicados")
+		}
+		pares = append(pares, par)
+	}
+	return pares, rows.Err()
+}
+
+func (r *ContatoPostgres) FindByIDs(ctx context.Context, ids []int64) ([]*entity.Contato, error) {
+	agenda, err := agendaDoContexto(ctx)
+	if err != nil {
+		return nil, err
+	}
+	contatos, err := r.buscarContatos(ctx, "SELECT "+colunasContato+" FROM Contato c WHERE c.ID = ANY($1) AND c.IDAGENDA = $2 AND c.DELETED_AT IS NULL ORDER BY c.ID", ids, agenda)
+	if err != nil {
+		return nil, errors.WrapErrorf(err, "repositorio: falha ao consultar contatos %v", ids)
+	}
+	return contatos, nil
+}
+
+func (r *ContatoPostgres) Mesclar(ctx context.Context, principal int64, outros []int64) (*entity.Contato, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.WrapErrorf(err, "repositorio: falha ao iniciar transacao para mesclar contatos no contato %d", principal)
+	}
+	defer tx.Rollback()
+
+	ids := append([]int64{principal}, outros...)
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	bloqueados := make(map[int64]*entity.Contato, len(ids))
+	for _, id := range ids {
+		contato, err := bloquearContato(ctx, tx, id)
+		if err != nil {
+			return nil, err
+		}
+		bloqueados[id] = contato
+	}
+
+	antes := bloqueados[principal]
+	mesclado := *antes
+	mesclado.Telefones = append([]entity.Telefone{}, antes.Telefones...)
+	numeros := make(map[string]bool, len(mesclado.Telefones))
+	for _, telefone := range mesclado.Telefones {
+		numeros[telefone.NumeroE164] = true
+	}
+	for _, id := range outros {
+		for _, telefone := range bloqueados[id].Telefones {
+			if numeros[telefone.NumeroE164] {
+				continue
+			}
+			numeros[telefone.NumeroE164] = true
+			mesclado.Telefones = append(mesclado.Telefones, entity.Telefone{Numero: telefone.Numero, NumeroE164: telefone.NumeroE164, Tipo: telefone.Tipo, Rotulo: telefone.Rotulo})
+		}
+	}
+
+	mesclado.Emails = append([]entity.Email{}, antes.Emails...)
+	enderecos := make(map[string]bool, len(mesclado.Emails))
+	for _, email := range mesclado.Emails {
+		enderecos[strings.ToLower(email.Endereco)] = true
+	}
+	for _, id := range outros {
+		for _, email := range bloqueados[id].Emails {
+			if enderecos[strings.ToLower(email.Endereco)] {
+				continue
+			}
+			enderecos[strings.ToLower(email.Endereco)] = true
+			mesclado.Emails = append(mesclado.Emails, entity.Email{Endereco: email.Endereco})
+		}
+	}
+
+	mesclado.Enderecos = append([]entity.Endereco{}, antes.Enderecos...)
+	for _, id := range outros {
+		for _, endereco := range bloqueados[id].Enderecos {
+			if contemEndereco(mesclado.Enderecos, endereco) {
+				continue
+			}
+			endereco.ID = 0
+			mesclado.Enderecos = append(mesclado.Enderecos, endereco)
+		}
+	}
+
+	if _, err := sincronizarTelefones(ctx, tx, &mesclado); err != nil {
+		return nil, err
+	}
+	if err := sincronizarEmails(ctx, tx, &mesclado); err != nil {
+		return nil, err
+	}
+	if err := sincronizarEnderecos(ctx, tx, &mesclado); err != nil {
+		return nil, err
+	}
+	if err := incrementarVersao(ctx, tx, antes); err != nil {
+		return nil, err
+	}
+
+	for _, id := range outros {
+		if _, err := tx.ExecContext(ctx, "UPDATE Contato SET DELETED_AT = now() WHERE ID = $1", id); err != nil {
+			return nil, errors.WrapErrorf(err, "repositorio: falha ao excluir contato %d mesclado no contato %d", id, principal)
+		}
+		depois, err := carregarContato(ctx, tx, id)
+		if err != nil {
+			return nil, err
+		}
+		if err := registrarAuditoria(ctx, tx, entity.OperacaoExclusao, id, bloqueados[id], depois); err != nil {
+			return nil, err
+		}
+	}
+
+	resultado, err := carregarContato(ctx, tx, principal)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, errors.WrapErrorf(err, "repositorio: falha ao confirmar mesclagem no contato %d", principal)
+	}
+	return resultado, nil
+}
+
+func contemEndereco(enderecos []entity.Endereco, procurado entity.Endereco) bool {
+	for _, endereco := range enderecos {
+		if endereco.CEP == procurado.CEP && strings.EqualFold(endereco.Numero, procurado.Numero) &&
+			strings.EqualFold(endereco.Complemento, procurado.Complemento) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/robitooS/backend/internal/entity"
+	"github.com/robitooS/backend/internal/errors"
+)
+
+func inserirEmails(ctx context.Context, tx *sql.Tx, contato *entity.Contato) error {
+	for i := range contato.Emails {
+		email := &contato.Emails[i]
+		email.IDContato = contato.ID
+		email.ID = int64(i + 1)
+		_, err := tx.ExecContext(ctx, "INSERT INTO Email (IDCONTATO, ID, ENDERECO) VALUES ($1, $2, $3)",
+			email.IDContato, email.ID, email.Endereco)
+		if err != nil {
+			return errors.WrapErrorf(err, "repositorio: falha ao inserir email para o contato %d", contato.ID)
+		}
+	}
+	return nil
+}
+
+func carregarEmails(ctx context.Context, q consultor, contatos []*entity.Contato) error {
+	if len(contatos) == 0 {
+		return nil
+	}
+
+	porID := make(map[int64]*entity.Contato, len(contatos))
+	ids := make([]int64, 0, len(contatos))
+	for _, contato := range contatos {
+		porID[contato.ID] = contato
+		ids = append(ids, contato.ID)
+	}
+
+	rows, err := q.QueryContext(ctx, "SELECT IDCONTATO, ID, ENDERECO FROM Email WHERE IDCONTATO = ANY($1) ORDER BY IDCONTATO, ID", ids)
+	if err != nil {
+		return errors.WrapErrorf(err, "repositorio: falha ao consultar emails dos contatos")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var email entity.Email
+		if err := rows.Scan(&email.IDContato, &email.ID, &email.Endereco); err != nil {
+			return errors.WrapErrorf(err, "repositorio: falha ao escanear linha da consulta de emails")
+		}
+		contato := porID[email.IDContato]
+		contato.Emails = append(contato.Emails, email)
+	}
+	return rows.Err()
+}
+
+func sincronizarEmails(ctx context.Context, tx *sql.Tx, contato *entity.Contato) error {
+	rows, err := tx.QueryContext(ctx, "SELECT ID, ENDERECO FROM Email WHERE IDCONTATO = $1", contato.ID)
+	if err != nil {
+		return errors.WrapErrorf(err, "repositorio: falha ao consultar emails do contato %d", contato.ID)
+	}
+	armazenados := make(map[int64]string)
+	var proximoID int64
+	for rows.Next() {
+		var id int64
+		var endereco string
+		if err := rows.Scan(&id, &endereco); err != nil {
+			rows.Close()
+			return errors.WrapErrorf(err, "repositorio: falha ao escanear linha da consulta de emails do contato %d", contato.ID)
+		}
+		armazenados[id] = endereco
+		if id > proximoID {
+			proximoID = id
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return errors.WrapErrorf(err, "repositorio: falha ao consultar emails do contato %d", contato.ID)
+	}
+
+	mantidos := make(map[int64]bool, len(contato.Emails))
+	for i := range contato.Emails {
+		email := &contato.Emails[i]
+		email.IDContato = contato.ID
+
+		atual, existe := armazenados[email.ID]
+		if existe && !mantidos[email.ID] {
+			mantidos[email.ID] = true
+			if atual == email.Endereco {
+				continue
+			}
+			_, err := tx.ExecContext(ctx, "UPDATE Email SET ENDERECO = $1 WHERE IDCONTATO = $2 AND ID = $3", email.Endereco, contato.ID, email.ID)
+			if err != nil {
+				return errors.WrapErrorf(err, "repositorio: falha ao atualizar email %d do contato %d", email.ID, contato.ID)
+			}
+			continue
+		}
+
+		proximoID++
+		email.ID = proximoID
+		_, err := tx.ExecContext(ctx, "INSERT INTO Email (IDCONTATO, ID, ENDERECO) VALUES ($1, $2, $3)", contato.ID, email.ID, email.Endereco)
+		if err != nil {
+			return errors.WrapErrorf(err, "repositorio: falha ao inserir email %d para o contato %d", email.ID, contato.ID)
+		}
+	}
+
+	var removidos []int64
+	for id := range armazenados {
+		if !mantidos[id] {
+			removidos = append(removidos, id)
+		}
+	}
+	if len(removidos) > 0 {
+		_, err := tx.ExecContext(ctx, "DELETE FROM Email WHERE IDCONTATO = $1 AND ID = ANY($2)", contato.ID, removidos)
+		if err != nil {
+			return errors.WrapErrorf(err, "repositorio: falha ao remover emails %v do contato %d", removidos, contato.ID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/robitooS/backend/internal/entity"
+	"github.com/robitooS/backend/internal/errors"
+)
+
+const colunasEndereco = "IDCONTATO, ID, LOGRADOURO, NUMERO, COMPLEMENTO, BAIRRO, CIDADE, UF, CEP"
+
+func inserirEnderecos(ctx context.Context, tx *sql.Tx, contato *entity.Contato) error {
+	for i := range contato.Enderecos {
+		endereco := &contato.Enderecos[i]
+		endereco.IDContato = contato.ID
+		endereco.ID = int64(i + 1)
+		if err := inserirEndereco(ctx, tx, endereco); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func inserirEndereco(ctx context.Context, tx *sql.Tx, endereco *entity.Endereco) error {
+	_, err := tx.ExecContext(ctx, "INSERT INTO Endereco ("+colunasEndereco+") VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)",
+		endereco.IDContato, endereco.ID, endereco.Logradouro, endereco.Numero, endereco.Complemento, endereco.Bairro, endereco.Cidade, endereco.UF, endereco.CEP)
+	if err != nil {
+		return errors.WrapErrorf(err, "repositorio: falha ao inserir endereco %d para o contato %d", endereco.ID, endereco.IDContato)
+	}
+	return nil
+}
+
+func carregarEnderecos(ctx context.Context, q consultor, contatos []*entity.Contato) error {
+	if len(contatos) == 0 {
+		return nil
+	}
+
+	porID := make(map[int64]*entity.Contato, len(contatos))
+	ids := make([]int64, 0, len(contatos))
+	for _, contato := range contatos {
+		porID[contato.ID] = contato
+		ids = append(ids, contato.ID)
+	}
+
+	rows, err := q.QueryContext(ctx, "SELECT "+colunasEndereco+" FROM Endereco WHERE IDCONTATO = ANY($1) ORDER BY IDCONTATO, ID", ids)
+	if err != nil {
+		return errors.WrapErrorf(err, "repositorio: falha ao consultar enderecos dos contatos")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		endereco, err := escanearEndereco(rows)
+		if err != nil {
+			return err
+		}
+		contato := porID[endereco.IDContato]
+		contato.Enderecos = append(contato.Enderecos, endereco)
+	}
+	return rows.Err()
+}
+
+func escanearEndereco(rows *sql.Rows) (entity.Endereco, error) {
+	var endereco entity.Endereco
+	err := rows.Scan(&endereco.IDContato, &endereco.ID, &endereco.Logradouro, &endereco.Numero, &endereco.Complemento,
+		&endereco.Bairro, &endereco.Cidade, &endereco.UF, &endereco.CEP)
+	if err != nil {
+		return endereco, errors.WrapErrorf(err, "repositorio: falha ao escanear linha da consulta de enderecos")
+	}
+	return endereco, nil
+}
+
+func sincronizarEnderecos(ctx context.Context, tx *sql.Tx, contato *entity.Contato) error {
+	rows, err := tx.QueryContext(ctx, "SELECT "+colunasEndereco+" FROM Endereco WHERE IDCONTATO = $1", contato.ID)
+	if err != nil {
+		return errors.WrapErrorf(err, "repositorio: falha ao consultar enderecos do contato %d", contato.ID)
+	}
+	armazenados := make(map[int64]entity.Endereco)
+	var proximoID int64
+	for rows.Next() {
+		endereco, err := escanearEndereco(rows)
+		if err != nil {
+			rows.Close()
+			return err
+		}
+		armazenados[endereco.ID] = endereco
+		if endereco.ID > proximoID {
+			proximoID = endereco.ID
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return errors.WrapErrorf(err, "repositorio: falha ao consultar enderecos do contato %d", contato.ID)
+	}
+
+	mantidos := make(map[int64]bool, len(contato.Enderecos))
+	for i := range contato.Enderecos {
+		endereco := &contato.Enderecos[i]
+		endereco.IDContato = contato.ID
+
+		atual, existe := armazenados[endereco.ID]
+		if existe && !mantidos[endereco.ID] {
+			mantidos[endereco.ID] = true
+			if atual == *endereco {
+				continue
+			}
+			_, err := tx.ExecContext(ctx, "UPDATE Endereco SET LOGRADOURO = $1, NUMERO = $2, COMPLEMENTO = $3, BAIRRO = $4, CIDADE = $5, UF = $6, CEP = $7 WHERE IDCONTATO = $8 AND ID = $9",
+				endereco.Logradouro, endereco.Numero, endereco.Complemento, endereco.Bairro, endereco.Cidade, endereco.UF, endereco.CEP, contato.ID, endereco.ID)
+			if err != nil {
+				return errors.WrapErrorf(err, "repositorio: falha ao atualizar endereco %d do contato %d", endereco.ID, contato.ID)
+			}
+			continue
+		}
+
+		proximoID++
+		endereco.ID = proximoID
+		if err := inserirEndereco(ctx, tx, endereco); err != nil {
+			return err
+		}
+	}
+
+	var removidos []int64
+	for id := range armazenados {
+		if !mantidos[id] {
+			removidos = append(removidos, id)
+		}
+	}
+	if len(removidos) > 0 {
+		_, err := tx.ExecContext(ctx, "DELETE FROM Endereco WHERE IDCONTATO = $1 AND ID = ANY($2)", contato.ID, removidos)
+		if err != nil {
+			return errors.WrapErrorf(err, "repositorio: falha ao remover enderecos %v do contato %d", removidos, contato.ID)
+		}
+	}
+	return nil
+}
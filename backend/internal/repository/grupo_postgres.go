@@ -0,0 +1,196 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	stdErrors "errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/robitooS/backend/internal/entity"
+	"github.com/robitooS/backend/internal/errors"
+)
+
+type GrupoPostgres struct {
+	db *sql.DB
+}
+
+func NewGrupoPostgres(db *sql.DB) *GrupoPostgres {
+	return &GrupoPostgres{db: db}
+}
+
+const consultaGrupos = `SELECT g.ID, g.IDAGENDA, g.NOME, g.DESCRICAO,
+	(SELECT COUNT(*) FROM ContatoGrupo cg JOIN Contato c ON c.ID = cg.IDCONTATO WHERE cg.IDGRUPO = g.ID AND c.DELETED_AT IS NULL)
+FROM Grupo g`
+
+func (r *GrupoPostgres) Create(ctx context.Context, grupo *entity.Grupo) error {
+	agenda, err := agendaDoContexto(ctx)
+	if err != nil {
+		return err
+	}
+	grupo.IDAgenda = agenda
+	err = r.db.QueryRowContext(ctx, "INSERT INTO Grupo (IDAGENDA, NOME, DESCRICAO) VALUES ($1, $2, $3) RETURNING ID", agenda, grupo.Nome, grupo.Descricao).Scan(&grupo.ID)
+	if violacaoUnica(err) {
+		return errors.WrapErrorf(errors.ErrAlreadyExists, "repositorio: grupo %q ja existe", grupo.Nome)
+	}
+	if err != nil {
+		return errors.WrapErrorf(err, "repositorio: falha ao inserir grupo")
+	}
+	return nil
+}
+
+func (r *GrupoPostgres) FindAll(ctx context.Context) ([]*entity.Grupo, error) {
+	agenda, err := agendaDoContexto(ctx)
+	if err != nil {
+		return nil, err
+	}
+	grupos, err := r.buscarGrupos(ctx, consultaGrupos+" WHERE g.IDAGENDA = $1 ORDER BY lower(g.NOME)", agenda)
+	if err != nil {
+		return nil, errors.WrapErrorf(err, "repositorio: falha ao consultar grupos")
+	}
+	return grupos, nil
+}
+
+func (r *GrupoPostgres) FindByID(ctx context.Context, id int64) (*entity.Grupo, error) {
+	agenda, err := agendaDoContexto(ctx)
+	if err != nil {
+		return nil, err
+	}
+	grupos, err := r.buscarGrupos(ctx, consultaGrupos+" WHERE g.ID = $1 AND g.IDAGENDA = $2", id, agenda)
+	if err != nil {
+		return nil, errors.WrapErrorf(err, "repositorio: falha ao consultar grupo %d", id)
+	}
+	if len(grupos) == 0 {
+		return nil, errors.WrapErrorf(errors.ErrNotFound, "repositorio: grupo %d nao encontrado", id)
+	}
+	return grupos[0], nil
+}
+
+func (r *GrupoPostgres) FindByContato(ctx context.Context, idContato int64) ([]*entity.Grupo, error) {
+	agenda, err := agendaDoContexto(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var existe bool
+	err = r.db.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM Contato WHERE ID = $1 AND IDAGENDA = $2 AND DELETED_AT IS NULL)", idContato, agenda).Scan(&existe)
+	if err != nil {
+		return nil, errors.WrapErrorf(err, "repositorio: falha ao verificar existencia do contato %d", idContato)
+	}
+	if !existe {
+		return nil, errors.WrapErrorf(errors.ErrNotFound, "repositorio: contato %d nao encontrado", idContato)
+	}
+
+	grupos, err := r.buscarGrupos(ctx, consultaGrupos+" WHERE g.IDAGENDA = $2 AND EXISTS (SELECT 1 FROM ContatoGrupo m WHERE m.IDGRUPO = g.ID AND m.IDCONTATO = $1) ORDER BY lower(g.NOME)", idContato, agenda)
+	if err != nil {
+		return nil, errors.WrapErrorf(err, "repositorio: falha ao consultar grupos do contato %d", idContato)
+	}
+	return grupos, nil
+}
+
+func (r *GrupoPostgres) buscarGrupos(ctx context.Context, query string, args ...interface{}) ([]*entity.Grupo, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	grupos := []*entity.Grupo{}
+	for rows.Next() {
+		grupo := &entity.Grupo{}
+		if err := rows.Scan(&grupo.ID, &grupo.IDAgenda, &grupo.Nome, &grupo.Descricao, &grupo.TotalContatos); err != nil {
+			return nil, errors.WrapErrorf(err, "repositorio: falha ao escanear linha da consulta de grupos")
+		}
+		grupos = append(grupos, grupo)
+	}
+	return grupos, rows.Err()
+}
+
+func (r *GrupoPostgres) Update(ctx context.Context, grupo *entity.Grupo) error {
+	agenda, err := agendaDoContexto(ctx)
+	if err != nil {
+		return err
+	}
+	res, err := r.db.ExecContext(ctx, "UPDATE Grupo SET NOME = $1, DESCRICAO = $2 WHERE ID = $3 AND IDAGENDA = $4", grupo.Nome, grupo.Descricao, grupo.ID, agenda)
+	if violacaoUnica(err) {
+		return errors.WrapErrorf(errors.ErrAlreadyExists, "repositorio: grupo %q ja existe", grupo.Nome)
+	}
+	if err != nil {
+		return errors.WrapErrorf(err, "repositorio: falha ao atualizar grupo %d", grupo.ID)
+	}
+	rowsAffected, _ := res.RowsAffected()
+	if rowsAffected == 0 {
+		return errors.WrapErrorf(errors.ErrNotFound, "repositorio: grupo %d nao encontrado", grupo.ID)
+	}
+	return nil
+}
+
+func (r *GrupoPostgres) Delete(ctx context.Context, id int64) error {
+	agenda, err := agendaDoContexto(ctx)
+	if err != nil {
+		return err
+	}
+	res, err := r.db.ExecContext(ctx, "DELETE FROM Grupo WHERE ID = $1 AND IDAGENDA = $2", id, agenda)
+	if err != nil {
+		return errors.WrapErrorf(err, "repositorio: falha ao excluir grupo %d", id)
+	}
+	rowsAffected, _ := res.RowsAffected()
+	if rowsAffected == 0 {
+		return errors.WrapErrorf(errors.ErrNotFound, "repositorio: grupo %d nao encontrado", id)
+	}
+	return nil
+}
+
+func (r *GrupoPostgres) AdicionarContato(ctx context.Context, idGrupo int64, idContato int64) error {
+	agenda, err := agendaDoContexto(ctx)
+	if err != nil {
+		return err
+	}
+	res, err := r.db.ExecContext(ctx, `INSERT INTO ContatoGrupo (IDGRUPO, IDCONTATO)
+		SELECT g.ID, c.ID FROM Grupo g, Contato c WHERE g.ID = $1 AND g.IDAGENDA = $3 AND c.ID = $2 AND c.IDAGENDA = $3 AND c.DELETED_AT IS NULL
+		ON CONFLICT DO NOTHING`, idGrupo, idContato, agenda)
+	if err != nil {
+		return errors.WrapErrorf(err, "repositorio: falha ao adicionar contato %d ao grupo %d", idContato, idGrupo)
+	}
+	rowsAffected, _ := res.RowsAffected()
+	if rowsAffected > 0 {
+		return nil
+	}
+	return r.membroNaoEncontrado(ctx, idGrupo, idContato, agenda)
+}
+
+func (r *GrupoPostgres) RemoverContato(ctx context.Context, idGrupo int64, idContato int64) error {
+	agenda, err := agendaDoContexto(ctx)
+	if err != nil {
+		return err
+	}
+	res, err := r.db.ExecContext(ctx, "DELETE FROM ContatoGrupo cg USING Contato c WHERE cg.IDGRUPO = $1 AND cg.IDCONTATO = $2 AND c.ID = cg.IDCONTATO AND c.IDAGENDA = $3",
+		idGrupo, idContato, agenda)
+	if err != nil {
+		return errors.WrapErrorf(err, "repositorio: falha ao remover contato %d do grupo %d", idContato, idGrupo)
+	}
+	rowsAffected, _ := res.RowsAffected()
+	if rowsAffected == 0 {
+		return errors.WrapErrorf(errors.ErrNotFound, "repositorio: contato %d nao pertence ao grupo %d", idContato, idGrupo)
+	}
+	return nil
+}
+
+func (r *GrupoPostgres) membroNaoEncontrado(ctx context.Context, idGrupo int64, idContato int64, agenda int64) error {
+	var grupoExiste, contatoExiste bool
+	err := r.db.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM Grupo WHERE ID = $1 AND IDAGENDA = $3), EXISTS (SELECT 1 FROM Contato WHERE ID = $2 AND IDAGENDA = $3 AND DELETED_AT IS NULL)",
+		idGrupo, idContato, agenda).Scan(&grupoExiste, &contatoExiste)
+	if err != nil {
+		return errors.WrapErrorf(err, "repositorio: falha ao verificar grupo %d e contato %d", idGrupo, idContato)
+	}
+	if !grupoExiste {
+		return errors.WrapErrorf(errors.ErrNotFound, "repositorio: grupo %d nao encontrado", idGrupo)
+	}
+	if !contatoExiste {
+		return errors.WrapErrorf(errors.ErrNotFound, "repositorio: contato %d nao encontrado", idContato)
+	}
+	return nil
+}
+
+func violacaoUnica(err error) bool {
+	var pgErr *pgconn.PgError
+	return stdErrors.As(err, &pgErr) && pgErr.Code == "23505"
+}
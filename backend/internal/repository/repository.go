@@ -7,10 +7,63 @@ import (
 )
 
 type ContatoRepository interface {
-	Create(ctx context.Context ,contato *entity.Contato) error
+	Create(ctx context.Context, contato *entity.Contato) error
+	CreateLote(ctx context.Context, contatos []*entity.Contato) (int, error)
 	FindAll(ctx context.Context) ([]*entity.Contato, error)
-	FindWithFilters(ctx context.Context, nome string, numero string) ([]*entity.Contato, error)
+	FindWithFilters(ctx context.Context, filtro entity.FiltroContato, pag entity.Paginacao) (*entity.PaginaContatos, error)
 	FindByID(ctx context.Context, id int64) (*entity.Contato, error)
-	Update(ctx context.Context, contato *entity.Contato) error
+	FindAniversariantes(ctx context.Context, diasDoAno []int64) ([]*entity.Contato, error)
+	Update(ctx context.Context, contato *entity.Contato) (entity.AlteracoesTelefones, error)
 	Delete(ctx context.Context, id int64) error
+	Restore(ctx context.Context, id int64) error
+	Purge(ctx context.Context, id int64) error
+
+	FindTelefones(ctx context.Context, idContato int64) ([]entity.Telefone, error)
+	FindTelefone(ctx context.Context, idContato int64, id int64) (*entity.Telefone, error)
+	CreateTelefone(ctx context.Context, telefone *entity.Telefone) error
+	UpdateTelefone(ctx context.Context, telefone *entity.Telefone) error
+	DeleteTelefone(ctx context.Context, idContato int64, id int64) error
+
+	FindRevisoes(ctx context.Context, idContato int64) ([]entity.Revisao, error)
+	FindRevisao(ctx context.Context, idContato int64, rev int64) (*entity.Revisao, error)
+
+	FindParesDuplicados(ctx context.Context) ([]entity.ParDuplicado, error)
+	FindByIDs(ctx context.Context, ids []int64) ([]*entity.Contato, error)
+	Mesclar(ctx context.Context, principal int64, outros []int64) (*entity.Contato, error)
+}
+
+type AuditoriaRepository interface {
+	FindWithFilters(ctx context.Context, filtro entity.FiltroAuditoria, pag entity.Paginacao) (*entity.PaginaAuditoria, error)
+}
+
+type GrupoRepository interface {
+	Create(ctx context.Context, grupo *entity.Grupo) error
+	FindAll(ctx context.Context) ([]*entity.Grupo, error)
+	FindByID(ctx context.Context, id int64) (*entity.Grupo, error)
+	FindByContato(ctx context.Context, idContato int64) ([]*entity.Grupo, error)
+	Update(ctx context.Context, grupo *entity.Grupo) error
+	Delete(ctx context.Context, id int64) error
+	AdicionarContato(ctx context.Context, idGrupo int64, idContato int64) error
+	RemoverContato(ctx context.Context, idGrupo int64, idContato int64) error
+}
+
+type UsuarioRepository interface {
+	Create(ctx context.Context, usuario *entity.Usuario) error
+	FindAll(ctx context.Context) ([]*entity.Usuario, error)
+	FindByID(ctx context.Context, id int64) (*entity.Usuario, error)
+	FindByLogin(ctx context.Context, login string) (*entity.Usuario, error)
+	Count(ctx context.Context) (int64, error)
+	AlterarPapel(ctx context.Context, id int64, papel entity.Papel) error
+}
+
+type AgendaRepository interface {
+	Create(ctx context.Context, agenda *entity.Agenda) error
+	FindByUsuario(ctx context.Context, idUsuario int64) ([]*entity.Agenda, error)
+	FindByID(ctx context.Context, id int64, idUsuario int64) (*entity.Agenda, error)
+	Update(ctx context.Context, agenda *entity.Agenda) error
+	Delete(ctx context.Context, id int64) error
+	FindCompartilhamentos(ctx context.Context, idAgenda int64) ([]entity.Compartilhamento, error)
+	Compartilhar(ctx context.Context, compartilhamento *entity.Compartilhamento) error
+	RemoverCompartilhamento(ctx context.Context, idAgenda int64, idUsuario int64) error
+	AdotarSemDono(ctx context.Context) (int64, error)
 }
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/robitooS/backend/internal/entity"
+	"github.com/robitooS/backend/internal/errors"
+	"github.com/robitooS/backend/internal/requisicao"
+)
+
+const colunasRevisao = "IDCONTATO, REVISAO, DATA, USUARIO, CONTATO"
+
+func (r *ContatoPostgres) FindRevisoes(ctx context.Context, idContato int64) ([]entity.Revisao, error) {
+	if err := r.contatoExiste(ctx, idContato); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.QueryContext(ctx, "SELECT "+colunasRevisao+" FROM Revisao WHERE IDCONTATO = $1 ORDER BY REVISAO DESC", idContato)
+	if err != nil {
+		return nil, errors.WrapErrorf(err, "repositorio: falha ao consultar revisoes do contato %d", idContato)
+	}
+	defer rows.Close()
+
+	revisoes := []entity.Revisao{}
+	for rows.Next() {
+		revisao, err := escanearRevisao(rows)
+		if err != nil {
+			return nil, errors.WrapErrorf(err, "repositorio: falha ao escanear linha da consulta de revisoes do contato %d", idContato)
+		}
+		revisoes = append(revisoes, *revisao)
+	}
+	return revisoes, rows.Err()
+}
+
+func (r *ContatoPostgres) FindRevisao(ctx context.Context, idContato int64, rev int64) (*entity.Revisao, error) {
+	if err := r.contatoExiste(ctx, idContato); err != nil {
+		return nil, err
+	}
+
+	revisao, err := escanearRevisao(r.db.QueryRowContext(ctx, "SELECT "+colunasRevisao+" FROM Revisao WHERE IDCONTATO = $1 AND REVISAO = $2", idContato, rev))
+	if err == sql.ErrNoRows {
+		return nil, errors.WrapErrorf(errors.ErrNotFound, "repositorio: revisao %d do contato %d nao encontrada", rev, idContato)
+	}
+	if err != nil {
+		return nil, errors.WrapErrorf(err, "repositorio: falha ao consultar revisao %d do contato %d", rev, idContato)
+	}
+	return revisao, nil
+}
+
+type escaneavel interface {
+	Scan(dest ...interface{}) error
+}
+
+func escanearRevisao(linha escaneavel) (*entity.Revisao, error) {
+	var revisao entity.Revisao
+	var contato []byte
+	if err := linha.Scan(&revisao.IDContato, &revisao.Revisao, &revisao.Data, &revisao.Usuario, &contato); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(contato, &revisao.Contato); err != nil {
+		return nil, errors.WrapErrorf(err, "repositorio: revisao %d do contato %d corrompida", revisao.Revisao, revisao.IDContato)
+	}
+	return &revisao, nil
+}
+
+func registrarRevisao(ctx context.Context, tx *sql.Tx, contato *entity.Contato) error {
+	dados, err := instantaneo(contato)
+	if err != nil {
+		return err
+	}
+
+	// Emails e enderecos vazios sao gravados como listas vazias para que a reversao os distinga de revisoes antigas, sem esses campos.
+	_, err = tx.ExecContext(ctx, `INSERT INTO Revisao (IDCONTATO, REVISAO, USUARIO, CONTATO)
+		VALUES ($1, $2, $3, jsonb_build_object('emails', '[]'::jsonb, 'enderecos', '[]'::jsonb) || $4::jsonb) ON CONFLICT (IDCONTATO, REVISAO) DO NOTHING`,
+		contato.ID, contato.Versao, requisicao.Autor(ctx), dados)
+	if err != nil {
+		return errors.WrapErrorf(err, "repositorio: falha ao registrar revisao %d do contato %d", contato.Versao, contato.ID)
+	}
+	return nil
+}
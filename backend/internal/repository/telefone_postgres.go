@@ -0,0 +1,322 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/robitooS/backend/internal/entity"
+	"github.com/robitooS/backend/internal/errors"
+)
+
+const colunasTelefone = "IDCONTATO, ID, NUMERO, NUMERO_E164, TIPO, ROTULO, PRINCIPAL"
+
+func (r *ContatoPostgres) FindTelefones(ctx context.Context, idContato int64) ([]entity.Telefone, error) {
+	if err := r.contatoExiste(ctx, idContato); err != nil {
+		return nil, err
+	}
+
+	rows, err := r.db.QueryContext(ctx, "SELECT "+colunasTelefone+" FROM Telefone WHERE IDCONTATO = $1 ORDER BY ID", idContato)
+	if err != nil {
+		return nil, errors.WrapErrorf(err, "repositorio: falha ao consultar telefones do contato %d", idContato)
+	}
+	defer rows.Close()
+
+	telefones := []entity.Telefone{}
+	for rows.Next() {
+		var telefone entity.Telefone
+		if err := rows.Scan(&telefone.IDContato, &telefone.ID, &telefone.Numero, &telefone.NumeroE164, &telefone.Tipo, &telefone.Rotulo, &telefone.Principal); err != nil {
+			return nil, errors.WrapErrorf(err, "repositorio: falha ao escanear linha da consulta de telefones do contato %d", idContato)
+		}
+		telefones = append(telefones, telefone)
+	}
+	return telefones, rows.Err()
+}
+
+func (r *ContatoPostgres) FindTelefone(ctx context.Context, idContato int64, id int64) (*entity.Telefone, error) {
+	if err := r.contatoExiste(ctx, idContato); err != nil {
+		return nil, err
+	}
+
+	var telefone entity.Telefone
+	err := r.db.QueryRowContext(ctx, "SELECT "+colunasTelefone+" FROM Telefone WHERE IDCONTATO = $1 AND ID = $2", idContato, id).
+		Scan(&telefone.IDContato, &telefone.ID, &telefone.Numero, &telefone.NumeroE164, &telefone.Tipo, &telefone.Rotulo, &telefone.Principal)
+	if err == sql.ErrNoRows {
+		return nil, errors.WrapErrorf(errors.ErrNotFound, "repositorio: telefone %d do contato %d nao encontrado", id, idContato)
+	}
+	if err != nil {
+		return nil, errors.WrapErrorf(err, "repositorio: falha ao consultar telefone %d do contato %d", id, idContato)
+	}
+	return &telefone, nil
+}
+
+func (r *ContatoPostgres) contatoExiste(ctx context.Context, id int64) error {
+	agenda, err := agendaDoContexto(ctx)
+	if err != nil {
+		return err
+	}
+	var existe bool
+	err = r.db.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM Contato WHERE ID = $1 AND IDAGENDA = $2 AND DELETED_AT IS NULL)", id, agenda).Scan(&existe)
+	if err != nil {
+		return errors.WrapErrorf(err, "repositorio: falha ao verificar existencia do contato %d", id)
+	}
+	if !existe {
+		return errors.WrapErrorf(errors.ErrNotFound, "repositorio: contato %d nao encontrado", id)
+	}
+	return nil
+}
+
+func (r *ContatoPostgres) CreateTelefone(ctx context.Context, telefone *entity.Telefone) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.WrapErrorf(err, "repositorio: falha ao iniciar transacao para criar telefone do contato %d", telefone.IDContato)
+	}
+	defer tx.Rollback()
+
+	antes, err := bloquearContato(ctx, tx, telefone.IDContato)
+	if err != nil {
+		return err
+	}
+
+	err = tx.QueryRowContext(ctx, "INSERT INTO Telefone ("+colunasTelefone+") SELECT $1, COALESCE(MAX(ID), 0) + 1, $2, $3, $4, $5, FALSE FROM Telefone WHERE IDCONTATO = $1 RETURNING ID",
+		telefone.IDContato, telefone.Numero, telefone.NumeroE164, telefone.Tipo, telefone.Rotulo).Scan(&telefone.ID)
+	if err != nil {
+		return errors.WrapErrorf(err, "repositorio: falha ao inserir telefone para o contato %d", telefone.IDContato)
+	}
+
+	preferido := int64(0)
+	if telefone.Principal {
+		preferido = telefone.ID
+	}
+	escolhido, err := escolherPrincipal(ctx, tx, telefone.IDContato, preferido, 0)
+	if err != nil {
+		return err
+	}
+	telefone.Principal = escolhido == telefone.ID
+
+	if err := incrementarVersao(ctx, tx, antes); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *ContatoPostgres) UpdateTelefone(ctx context.Context, telefone *entity.Telefone) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.WrapErrorf(err, "repositorio: falha ao iniciar transacao para atualizar telefone %d do contato %d", telefone.ID, telefone.IDContato)
+	}
+	defer tx.Rollback()
+
+	antes, err := bloquearContato(ctx, tx, telefone.IDContato)
+	if err != nil {
+		return err
+	}
+
+	res, err := tx.ExecContext(ctx, "UPDATE Telefone SET NUMERO = $1, NUMERO_E164 = $2, TIPO = $3, ROTULO = $4 WHERE IDCONTATO = $5 AND ID = $6",
+		telefone.Numero, telefone.NumeroE164, telefone.Tipo, telefone.Rotulo, telefone.IDContato, telefone.ID)
+	if err != nil {
+		return errors.WrapErrorf(err, "repositorio: falha ao atualizar telefone %d do contato %d", telefone.ID, telefone.IDContato)
+	}
+	rowsAffected, _ := res.RowsAffected()
+	if rowsAffected == 0 {
+		return errors.WrapErrorf(errors.ErrNotFound, "repositorio: telefone %d do contato %d nao encontrado", telefone.ID, telefone.IDContato)
+	}
+
+	preferido, evitado := int64(0), telefone.ID
+	if telefone.Principal {
+		preferido, evitado = telefone.ID, 0
+	}
+	escolhido, err := escolherPrincipal(ctx, tx, telefone.IDContato, preferido, evitado)
+	if err != nil {
+		return err
+	}
+	telefone.Principal = escolhido == telefone.ID
+
+	if err := incrementarVersao(ctx, tx, antes); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *ContatoPostgres) DeleteTelefone(ctx context.Context, idContato int64, id int64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.WrapErrorf(err, "repositorio: falha ao iniciar transacao para deletar telefone %d do contato %d", id, idContato)
+	}
+	defer tx.Rollback()
+
+	antes, err := bloquearContato(ctx, tx, idContato)
+	if err != nil {
+		return err
+	}
+
+	res, err := tx.ExecContext(ctx, "DELETE FROM Telefone WHERE IDCONTATO = $1 AND ID = $2", idContato, id)
+	if err != nil {
+		return errors.WrapErrorf(err, "repositorio: falha ao deletar telefone %d do contato %d", id, idContato)
+	}
+	rowsAffected, _ := res.RowsAffected()
+	if rowsAffected == 0 {
+		return errors.WrapErrorf(errors.ErrNotFound, "repositorio: telefone %d do contato %d nao encontrado", id, idContato)
+	}
+
+	if _, err := escolherPrincipal(ctx, tx, idContato, 0, 0); err != nil {
+		return err
+	}
+
+	if err := incrementarVersao(ctx, tx, antes); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func sincronizarTelefones(ctx context.Context, tx *sql.Tx, contato *entity.Contato) (entity.AlteracoesTelefones, error) {
+	var alteracoes entity.AlteracoesTelefones
+
+	rows, err := tx.QueryContext(ctx, "SELECT "+colunasTelefone+" FROM Telefone WHERE IDCONTATO = $1 ORDER BY ID", contato.ID)
+	if err != nil {
+		return alteracoes, errors.WrapErrorf(err, "repositorio: falha ao consultar telefones do contato %d", contato.ID)
+	}
+	var armazenados []entity.Telefone
+	for rows.Next() {
+		var telefone entity.Telefone
+		if err := rows.Scan(&telefone.IDContato, &telefone.ID, &telefone.Numero, &telefone.NumeroE164, &telefone.Tipo, &telefone.Rotulo, &telefone.Principal); err != nil {
+			rows.Close()
+			return alteracoes, errors.WrapErrorf(err, "repositorio: falha ao escanear linha da consulta de telefones do contato %d", contato.ID)
+		}
+		armazenados = append(armazenados, telefone)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return alteracoes, errors.WrapErrorf(err, "repositorio: falha ao consultar telefones do contato %d", contato.ID)
+	}
+
+	porID := make(map[int64]entity.Telefone, len(armazenados))
+	var proximoID int64
+	for _, telefone := range armazenados {
+		porID[telefone.ID] = telefone
+		if telefone.ID > proximoID {
+			proximoID = telefone.ID
+		}
+	}
+
+	mantidos := make(map[int64]bool, len(contato.Telefones))
+	for i := range contato.Telefones {
+		telefone := &contato.Telefones[i]
+		telefone.IDContato = contato.ID
+
+		atual, existe := porID[telefone.ID]
+		if existe && !mantidos[telefone.ID] {
+			mantidos[telefone.ID] = true
+			if atual.Numero == telefone.Numero && atual.NumeroE164 == telefone.NumeroE164 && atual.Tipo == telefone.Tipo && atual.Rotulo == telefone.Rotulo {
+				continue
+			}
+			_, err := tx.ExecContext(ctx, "UPDATE Telefone SET NUMERO = $1, NUMERO_E164 = $2, TIPO = $3, ROTULO = $4 WHERE IDCONTATO = $5 AND ID = $6",
+				telefone.Numero, telefone.NumeroE164, telefone.Tipo, telefone.Rotulo, contato.ID, telefone.ID)
+			if err != nil {
+				return alteracoes, errors.WrapErrorf(err, "repositorio: falha ao atualizar telefone %d do contato %d", telefone.ID, contato.ID)
+			}
+			alteracoes.Atualizados = append(alteracoes.Atualizados, telefone.ID)
+			continue
+		}
+
+		proximoID++
+		telefone.ID = proximoID
+		_, err := tx.ExecContext(ctx, "INSERT INTO Telefone ("+colunasTelefone+") VALUES ($1, $2, $3, $4, $5, $6, FALSE)",
+			contato.ID, telefone.ID, telefone.Numero, telefone.NumeroE164, telefone.Tipo, telefone.Rotulo)
+		if err != nil {
+			return alteracoes, errors.WrapErrorf(err, "repositorio: falha ao inserir telefone %d para o contato %d", telefone.ID, contato.ID)
+		}
+		alteracoes.Inseridos = append(alteracoes.Inseridos, telefone.ID)
+	}
+
+	for _, telefone := range armazenados {
+		if !mantidos[telefone.ID] {
+			alteracoes.Removidos = append(alteracoes.Removidos, telefone.ID)
+		}
+	}
+	if len(alteracoes.Removidos) > 0 {
+		_, err := tx.ExecContext(ctx, "DELETE FROM Telefone WHERE IDCONTATO = $1 AND ID = ANY($2)", contato.ID, alteracoes.Removidos)
+		if err != nil {
+			return alteracoes, errors.WrapErrorf(err, "repositorio: falha ao remover telefones %v do contato %d", alteracoes.Removidos, contato.ID)
+		}
+	}
+
+	preferido := int64(0)
+	if i := indicePrincipal(contato.Telefones); i >= 0 && contato.Telefones[i].Principal {
+		preferido = contato.Telefones[i].ID
+	}
+	escolhido, err := escolherPrincipal(ctx, tx, contato.ID, preferido, 0)
+	if err != nil {
+		return alteracoes, err
+	}
+	for i := range contato.Telefones {
+		contato.Telefones[i].Principal = contato.Telefones[i].ID == escolhido
+	}
+
+	return alteracoes, nil
+}
+
+// indicePrincipal devolve o telefone marcado como principal ou, sem marcacao, o primeiro da lista.
+func indicePrincipal(telefones []entity.Telefone) int {
+	for i, telefone := range telefones {
+		if telefone.Principal {
+			return i
+		}
+	}
+	if len(telefones) > 0 {
+		return 0
+	}
+	return -1
+}
+
+// escolherPrincipal mantem um unico telefone principal por contato: o preferido, senao o principal atual, senao o de menor ID.
+// O telefone evitado so e escolhido se for o unico. Desmarca antes de marcar para respeitar o indice unico.
+func escolherPrincipal(ctx context.Context, tx *sql.Tx, idContato int64, preferido int64, evitado int64) (int64, error) {
+	var escolhido int64
+	err := tx.QueryRowContext(ctx, "SELECT ID FROM Telefone WHERE IDCONTATO = $1 ORDER BY (ID = $2) DESC, (ID = $3) ASC, PRINCIPAL DESC, ID LIMIT 1",
+		idContato, preferido, evitado).Scan(&escolhido)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, errors.WrapErrorf(err, "repositorio: falha ao escolher telefone principal do contato %d", idContato)
+	}
+
+	if _, err := tx.ExecContext(ctx, "UPDATE Telefone SET PRINCIPAL = FALSE WHERE IDCONTATO = $1 AND PRINCIPAL AND ID <> $2", idContato, escolhido); err != nil {
+		return 0, errors.WrapErrorf(err, "repositorio: falha ao desmarcar telefone principal do contato %d", idContato)
+	}
+	if _, err := tx.ExecContext(ctx, "UPDATE Telefone SET PRINCIPAL = TRUE WHERE IDCONTATO = $1 AND ID = $2 AND NOT PRINCIPAL", idContato, escolhido); err != nil {
+		return 0, errors.WrapErrorf(err, "repositorio: falha ao marcar telefone principal do contato %d", idContato)
+	}
+	return escolhido, nil
+}
+
+func bloquearContato(ctx context.Context, tx *sql.Tx, id int64) (*entity.Contato, error) {
+	agenda, err := agendaDoContexto(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var bloqueado int64
+	err = tx.QueryRowContext(ctx, "SELECT ID FROM Contato WHERE ID = $1 AND IDAGENDA = $2 AND DELETED_AT IS NULL FOR UPDATE", id, agenda).Scan(&bloqueado)
+	if err == sql.ErrNoRows {
+		return nil, errors.WrapErrorf(errors.ErrNotFound, "repositorio: contato %d nao encontrado", id)
+	}
+	if err != nil {
+		return nil, errors.WrapErrorf(err, "repositorio: falha ao bloquear contato %d", id)
+	}
+	return carregarContato(ctx, tx, id)
+}
+
+func incrementarVersao(ctx context.Context, tx *sql.Tx, antes *entity.Contato) error {
+	if _, err := tx.ExecContext(ctx, "UPDATE Contato SET VERSAO = VERSAO + 1 WHERE ID = $1", antes.ID); err != nil {
+		return errors.WrapErrorf(err, "repositorio: falha ao incrementar versao do contato %d", antes.ID)
+	}
+
+	depois, err := carregarContato(ctx, tx, antes.ID)
+	if err != nil {
+		return err
+	}
+	if err := registrarRevisao(ctx, tx, depois); err != nil {
+		return err
+	}
+	return registrarAuditoria(ctx, tx, entity.OperacaoAtualizacao, antes.ID, antes, depois)
+}
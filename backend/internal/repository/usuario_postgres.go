@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/robitooS/backend/internal/entity"
+	"github.com/robitooS/backend/internal/errors"
+)
+
+type UsuarioPostgres struct {
+	db *sql.DB
+}
+
+func NewUsuarioPostgres(db *sql.DB) *UsuarioPostgres {
+	return &UsuarioPostgres{db: db}
+}
+
+const consultaUsuarios = "SELECT ID, LOGIN, NOME, PAPEL, SENHA_HASH, CRIADO_EM FROM Usuario"
+
+func (r *UsuarioPostgres) Create(ctx context.Context, usuario *entity.Usuario) error {
+	err := r.db.QueryRowContext(ctx, "INSERT INTO Usuario (LOGIN, NOME, PAPEL, SENHA_HASH) VALUES ($1, $2, $3, $4) RETURNING ID, CRIADO_EM",
+		usuario.Login, usuario.Nome, usuario.Papel, usuario.SenhaHash).Scan(&usuario.ID, &usuario.CriadoEm)
+	if violacaoUnica(err) {
+		return errors.WrapErrorf(errors.ErrAlreadyExists, "repositorio: usuario %q ja existe", usuario.Login)
+	}
+	if err != nil {
+		return errors.WrapErrorf(err, "repositorio: falha ao inserir usuario")
+	}
+	return nil
+}
+
+func (r *UsuarioPostgres) FindAll(ctx context.Context) ([]*entity.Usuario, error) {
+	usuarios, err := r.buscarUsuarios(ctx, consultaUsuarios+" ORDER BY lower(LOGIN)")
+	if err != nil {
+		return nil, errors.WrapErrorf(err, "repositorio: falha ao consultar usuarios")
+	}
+	return usuarios, nil
+}
+
+func (r *UsuarioPostgres) FindByID(ctx context.Context, id int64) (*entity.Usuario, error) {
+	usuarios, err := r.buscarUsuarios(ctx, consultaUsuarios+" WHERE ID = $1", id)
+	if err != nil {
+		return nil, errors.WrapErrorf(err, "repositorio: falha ao consultar usuario %d", id)
+	}
+	if len(usuarios) == 0 {
+		return nil, errors.WrapErrorf(errors.ErrNotFound, "repositorio: usuario %d nao encontrado", id)
+	}
+	return usuarios[0], nil
+}
+
+func (r *UsuarioPostgres) FindByLogin(ctx context.Context, login string) (*entity.Usuario, error) {
+	usuarios, err := r.buscarUsuarios(ctx, consultaUsuarios+" WHERE lower(LOGIN) = lower($1)", login)
+	if err != nil {
+		return nil, errors.WrapErrorf(err, "repositorio: falha ao consultar usuario %q", login)
+	}
+	if len(usuarios) == 0 {
+		return nil, errors.WrapErrorf(errors.ErrNotFound, "repositorio: usuario %q nao encontrado", login)
+	}
+	return usuarios[0], nil
+}
+
+func (r *UsuarioPostgres) Count(ctx context.Context) (int64, error) {
+	var total int64
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM Usuario").Scan(&total); err != nil {
+		return 0, errors.WrapErrorf(err, "repositorio: falha ao contar usuarios")
+	}
+	return total, nil
+}
+
+func (r *UsuarioPostgres) AlterarPapel(ctx context.Context, id int64, papel entity.Papel) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.WrapErrorf(err, "repositorio: falha ao iniciar transacao para alterar papel do usuario %d", id)
+	}
+	defer tx.Rollback()
+
+	// Bloqueia os administradores para que duas alteracoes simultaneas nao removam o ultimo
+	rows, err := tx.QueryContext(ctx, "SELECT ID FROM Usuario WHERE PAPEL = $1 ORDER BY ID FOR UPDATE", entity.PapelAdmin)
+	if err != nil {
+		return errors.WrapErrorf(err, "repositorio: falha ao bloquear administradores")
+	}
+	administradores := map[int64]bool{}
+	for rows.Next() {
+		var idAdmin int64
+		if err := rows.Scan(&idAdmin); err != nil {
+			rows.Close()
+			return errors.WrapErrorf(err, "repositorio: falha ao ler administradores")
+		}
+		administradores[idAdmin] = true
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return errors.WrapErrorf(err, "repositorio: falha ao ler administradores")
+	}
+	if papel != entity.PapelAdmin && administradores[id] && len(administradores) == 1 {
+		return errors.WrapErrorf(errors.ErrInvalidInput, "repositorio: usuario %d e o ultimo administrador", id)
+	}
+
+	res, err := tx.ExecContext(ctx, "UPDATE Usuario SET PAPEL = $2 WHERE ID = $1", id, papel)
+	if err != nil {
+		return errors.WrapErrorf(err, "repositorio: falha ao alterar papel do usuario %d", id)
+	}
+	rowsAffected, _ := res.RowsAffected()
+	if rowsAffected == 0 {
+		return errors.WrapErrorf(errors.ErrNotFound, "repositorio: usuario %d nao encontrado", id)
+	}
+	return tx.Commit()
+}
+
+func (r *UsuarioPostgres) buscarUsuarios(ctx context.Context, query string, args ...interface{}) ([]*entity.Usuario, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	usuarios := []*entity.Usuario{}
+	for rows.Next() {
+		var usuario entity.Usuario
+		if err := rows.Scan(&usuario.ID, &usuario.Login, &usuario.Nome, &usuario.Papel, &usuario.SenhaHash, &usuario.CriadoEm); err != nil {
+			return nil, err
+		}
+		usuarios = append(usuarios, &usuario)
+	}
+	return usuarios, rows.Err()
+}
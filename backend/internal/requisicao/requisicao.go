@@ -0,0 +1,46 @@
+package requisicao
+
+import (
+	"context"
+
+	"github.com/robitooS/backend/internal/entity"
+)
+
+const AutorAnonimo = "anonimo"
+
+type chaveAutor struct{}
+
+type chaveUsuario struct{}
+
+type chaveAgenda struct{}
+
+func ComAutor(ctx context.Context, autor string) context.Context {
+	return context.WithValue(ctx, chaveAutor{}, autor)
+}
+
+func Autor(ctx context.Context) string {
+	if autor, ok := ctx.Value(chaveAutor{}).(string); ok && autor != "" {
+		return autor
+	}
+	return AutorAnonimo
+}
+
+// ComUsuario guarda o usuario autenticado e o torna o autor das alteracoes.
+func ComUsuario(ctx context.Context, usuario *entity.Usuario) context.Context {
+	return ComAutor(context.WithValue(ctx, chaveUsuario{}, usuario), usuario.Login)
+}
+
+func Usuario(ctx context.Context) (*entity.Usuario, bool) {
+	usuario, ok := ctx.Value(chaveUsuario{}).(*entity.Usuario)
+	return usuario, ok && usuario != nil
+}
+
+// ComAgenda restringe as consultas de contatos da requisicao a agenda informada.
+func ComAgenda(ctx context.Context, idAgenda int64) context.Context {
+	return context.WithValue(ctx, chaveAgenda{}, idAgenda)
+}
+
+func Agenda(ctx context.Context) (int64, bool) {
+	id, ok := ctx.Value(chaveAgenda{}).(int64)
+	return id, ok && id > 0
+}
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/robitooS/backend/internal/entity"
+	customErrors "github.com/robitooS/backend/internal/errors"
+	"github.com/robitooS/backend/internal/repository"
+	"github.com/robitooS/backend/internal/requisicao"
+)
+
+type agendaService struct {
+	repo repository.AgendaRepository
+}
+
+func NewAgendaService(repo repository.AgendaRepository) AgendaService {
+	return &agendaService{
+		repo: repo,
+	}
+}
+
+func usuarioDaRequisicao(ctx context.Context) (*entity.Usuario, error) {
+	usuario, ok := requisicao.Usuario(ctx)
+	if !ok {
+		return nil, customErrors.WrapErrorf(customErrors.ErrUnauthorized, "servico: requisicao sem usuario autenticado")
+	}
+	return usuario, nil
+}
+
+func (s *agendaService) Create(ctx context.Context, agenda *entity.Agenda) error {
+	usuario, err := usuarioDaRequisicao(ctx)
+	if err != nil {
+		return err
+	}
+	if agenda.ID != 0 {
+		return customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: ID da agenda e gerado pelo servidor e nao deve ser informado")
+	}
+	if err := validarAgenda(agenda); err != nil {
+		return err
+	}
+
+	agenda.IDDono = usuario.ID
+	if err := s.repo.Create(ctx, agenda); err != nil {
+		return customErrors.WrapErrorf(err, "servico: falha ao criar agenda")
+	}
+	return nil
+}
+
+func validarAgenda(agenda *entity.Agenda) error {
+	agenda.Nome = strings.TrimSpace(agenda.Nome)
+	if len(agenda.Nome) < 2 || len(agenda.Nome) > 100 {
+		return customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: nome da agenda deve ter entre 2 e 100 caracteres")
+	}
+	return nil
+}
+
+func (s *agendaService) FindAll(ctx context.Context) ([]*entity.Agenda, error) {
+	usuario, err := usuarioDaRequisicao(ctx)
+	if err != nil {
+		return nil, err
+	}
+	agendas, err := s.repo.FindByUsuario(ctx, usuario.ID)
+	if err != nil {
+		return nil, customErrors.WrapErrorf(err, "servico: falha ao listar agendas")
+	}
+	return agendas, nil
+}
+
+func (s *agendaService) FindByID(ctx context.Context, id int64) (*entity.Agenda, error) {
+	return s.Acessar(ctx, id, false)
+}
+
+// Acessar devolve a agenda se o usuario da requisicao puder le-la ou, com escrita, altera-la.
+// Agendas de outros usuarios, nao compartilhadas, respondem como inexistentes.
+func (s *agendaService) Acessar(ctx context.Context, id int64, escrita bool) (*entity.Agenda, error) {
+	usuario, err := usuarioDaRequisicao(ctx)
+	if err != nil {
+		return nil, err
+	}
+	agenda, err := s.repo.FindByID(ctx, id, usuario.ID)
+	if err != nil {
+		return nil, customErrors.WrapErrorf(err, "servico: falha ao buscar agenda %d", id)
+	}
+	if escrita && !agenda.Permissao.PermiteEscrita() {
+		return nil, customErrors.WrapErrorf(customErrors.ErrForbidden, "servico: agenda %d compartilhada apenas para leitura", id)
+	}
+	return agenda, nil
+}
+
+func (s *agendaService) exigirDono(ctx context.Context, id int64) error {
+	agenda, err := s.Acessar(ctx, id, false)
+	if err != nil {
+		return err
+	}
+	if agenda.Permissao != entity.PermissaoDono {
+		return customErrors.WrapErrorf(customErrors.ErrForbidden, "servico: apenas o dono pode gerenciar a agenda %d", id)
+	}
+	return nil
+}
+
+func (s *agendaService) Update(ctx context.Context, agenda *entity.Agenda) error {
+	if err := s.exigirDono(ctx, agenda.ID); err != nil {
+		return err
+	}
+	if err := validarAgenda(agenda); err != nil {
+		return err
+	}
+	if err := s.repo.Update(ctx, agenda); err != nil {
+		return customErrors.WrapErrorf(err, "servico: falha ao atualizar agenda %d", agenda.ID)
+	}
+	return nil
+}
+
+func (s *agendaService) Delete(ctx context.Context, id int64) error {
+	if err := s.exigirDono(ctx, id); err != nil {
+		return err
+	}
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return customErrors.WrapErrorf(err, "servico: falha ao excluir agenda %d", id)
+	}
+	return nil
+}
+
+func (s *agendaService) FindCompartilhamentos(ctx context.Context, idAgenda int64) ([]entity.Compartilhamento, error) {
+	if err := s.exigirDono(ctx, idAgenda); err != nil {
+		return nil, err
+	}
+	compartilhamentos, err := s.repo.FindCompartilhamentos(ctx, idAgenda)
+	if err != nil {
+		return nil, customErrors.WrapErrorf(err, "servico: falha ao listar compartilhamentos da agenda %d", idAgenda)
+	}
+	return compartilhamentos, nil
+}
+
+func (s *agendaService) Compartilhar(ctx context.Context, compartilhamento *entity.Compartilhamento) error {
+	if err := s.exigirDono(ctx, compartilhamento.IDAgenda); err != nil {
+		return err
+	}
+	if compartilhamento.Permissao != entity.PermissaoLeitura && compartilhamento.Permissao != entity.PermissaoEscrita {
+		return customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: permissao %q invalida, use leitura ou escrita", compartilhamento.Permissao)
+	}
+	if usuario, _ := requisicao.Usuario(ctx); usuario.ID == compartilhamento.IDUsuario {
+		return customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: o dono nao pode compartilhar a agenda consigo mesmo")
+	}
+
+	if err := s.repo.Compartilhar(ctx, compartilhamento); err != nil {
+		return customErrors.WrapErrorf(err, "servico: falha ao compartilhar agenda %d", compartilhamento.IDAgenda)
+	}
+	return nil
+}
+
+func (s *agendaService) RemoverCompartilhamento(ctx context.Context, idAgenda int64, idUsuario int64) error {
+	if err := s.exigirDono(ctx, idAgenda); err != nil {
+		return err
+	}
+	if err := s.repo.RemoverCompartilhamento(ctx, idAgenda, idUsuario); err != nil {
+		return customErrors.WrapErrorf(err, "servico: falha ao remover compartilhamento da agenda %d", idAgenda)
+	}
+	return nil
+}
+
+func (s *agendaService) AdotarAgendasSemDono(ctx context.Context) (int64, error) {
+	total, err := s.repo.AdotarSemDono(ctx)
+	if err != nil {
+		return 0, customErrors.WrapErrorf(err, "servico: falha ao atribuir dono as agendas sem dono")
+	}
+	return total, nil
+}
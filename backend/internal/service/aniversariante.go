@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/robitooS/backend/internal/entity"
+	customErrors "github.com/robitooS/backend/internal/errors"
+)
+
+const (
+	DiasAniversariantesPadrao = 30
+	DiasAniversariantesMaximo = 366
+)
+
+func (s *contatoService) FindAniversariantes(ctx context.Context, dias int) ([]entity.Aniversariante, error) {
+	if dias < 0 || dias > DiasAniversariantesMaximo {
+		return nil, customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: dias deve estar entre 0 e %d", DiasAniversariantesMaximo)
+	}
+
+	hoje := entity.NovaData(time.Now())
+	contatos, err := s.repo.FindAniversariantes(ctx, diasDoAnoAte(hoje, dias))
+	if err != nil {
+		return nil, customErrors.WrapErrorf(err, "servico: falha ao buscar aniversariantes")
+	}
+
+	aniversariantes := make([]entity.Aniversariante, 0, len(contatos))
+	for _, contato := range contatos {
+		data := proximoAniversario(*contato.DataNascimento, hoje)
+		aniversariantes = append(aniversariantes, entity.Aniversariante{
+			Contato: contato,
+			Data:    data,
+			Dias:    int(data.Sub(hoje.Time).Hours() / 24),
+			Idade:   entity.IdadeEm(*contato.DataNascimento, data.Time),
+		})
+	}
+	sort.SliceStable(aniversariantes, func(i, j int) bool {
+		return aniversariantes[i].Dias < aniversariantes[j].Dias
+	})
+	return aniversariantes, nil
+}
+
+func diasDoAnoAte(inicio entity.Data, dias int) []int64 {
+	var chaves []int64
+	for i := 0; i <= dias; i++ {
+		dia := inicio.AddDate(0, 0, i)
+		chaves = append(chaves, int64(dia.Month())*100+int64(dia.Day()))
+		if dia.Month() == time.March && dia.Day() == 1 && !bissexto(dia.Year()) {
+			chaves = append(chaves, 229)
+		}
+	}
+	return chaves
+}
+
+func proximoAniversario(nascimento entity.Data, hoje entity.Data) entity.Data {
+	for ano := hoje.Year(); ; ano++ {
+		mes, dia := nascimento.Month(), nascimento.Day()
+		if mes == time.February && dia == 29 && !bissexto(ano) {
+			mes, dia = time.March, 1
+		}
+		data := entity.Data{Time: time.Date(ano, mes, dia, 0, 0, 0, 0, time.UTC)}
+		if !data.Before(hoje.Time) {
+			return data
+		}
+	}
+}
+
+func bissexto(ano int) bool {
+	return ano%4 == 0 && (ano%100 != 0 || ano%400 == 0)
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+
+	"github.com/robitooS/backend/internal/entity"
+	customErrors "github.com/robitooS/backend/internal/errors"
+	"github.com/robitooS/backend/internal/repository"
+)
+
+type auditoriaService struct {
+	repo repository.AuditoriaRepository
+}
+
+func NewAuditoriaService(repo repository.AuditoriaRepository) AuditoriaService {
+	return &auditoriaService{
+		repo: repo,
+	}
+}
+
+func (s *auditoriaService) FindWithFilters(ctx context.Context, filtro entity.FiltroAuditoria, pag entity.Paginacao) (*entity.PaginaAuditoria, error) {
+	if err := validarPaginacao(&pag); err != nil {
+		return nil, err
+	}
+	if err := validarFiltroAuditoria(filtro); err != nil {
+		return nil, err
+	}
+
+	pagina, err := s.repo.FindWithFilters(ctx, filtro, pag)
+	if err != nil {
+		return nil, customErrors.WrapErrorf(err, "servico: falha ao buscar registros de auditoria")
+	}
+	return pagina, nil
+}
+
+func validarFiltroAuditoria(filtro entity.FiltroAuditoria) error {
+	switch filtro.Operacao {
+	case "", entity.OperacaoCriacao, entity.OperacaoAtualizacao, entity.OperacaoExclusao, entity.OperacaoRestauracao, entity.OperacaoExpurgo:
+	default:
+		return customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: operacao de auditoria invalida %q", filtro.Operacao)
+	}
+	if filtro.IDContato < 0 {
+		return customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: ID do contato deve ser positivo")
+	}
+	if filtro.De != nil && filtro.Ate != nil && filtro.De.After(*filtro.Ate) {
+		return customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: data inicial deve ser anterior a data final")
+	}
+	return nil
+}
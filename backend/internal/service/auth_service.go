@@ -0,0 +1,153 @@
+package service
+
+import (
+	"context"
+	stdErrors "errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/robitooS/backend/internal/entity"
+	customErrors "github.com/robitooS/backend/internal/errors"
+	"github.com/robitooS/backend/internal/repository"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const emissorToken = "agenda"
+
+var loginValido = regexp.MustCompile(`^[a-z0-9._-]{3,100}$`)
+
+// Comparado quando o login nao existe, para que a resposta leve o mesmo tempo
+var hashFicticio, _ = bcrypt.GenerateFromPassword([]byte("senha-ficticia"), bcrypt.DefaultCost)
+
+type authService struct {
+	repo      repository.UsuarioRepository
+	segredo   []byte
+	expiracao time.Duration
+}
+
+func NewAuthService(repo repository.UsuarioRepository, segredo string, expiracao time.Duration) AuthService {
+	return &authService{
+		repo:      repo,
+		segredo:   []byte(segredo),
+		expiracao: expiracao,
+	}
+}
+
+type claimsToken struct {
+	Login string `json:"login"`
+	jwt.RegisteredClaims
+}
+
+func (s *authService) Login(ctx context.Context, credenciais entity.Credenciais) (*entity.Sessao, error) {
+	login := strings.ToLower(strings.TrimSpace(credenciais.Login))
+	if login == "" || credenciais.Senha == "" {
+		return nil, customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: login e senha sao obrigatorios")
+	}
+
+	usuario, err := s.repo.FindByLogin(ctx, login)
+	if err != nil && !stdErrors.Is(err, customErrors.ErrNotFound) {
+		return nil, customErrors.WrapErrorf(err, "servico: falha ao buscar usuario para login")
+	}
+	hash := hashFicticio
+	if usuario != nil {
+		hash = []byte(usuario.SenhaHash)
+	}
+	if bcrypt.CompareHashAndPassword(hash, []byte(credenciais.Senha)) != nil || usuario == nil {
+		return nil, customErrors.WrapErrorf(customErrors.ErrUnauthorized, "servico: login ou senha invalidos")
+	}
+
+	agora := time.Now()
+	expiraEm := agora.Add(s.expiracao)
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claimsToken{
+		Login: usuario.Login,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    emissorToken,
+			Subject:   strconv.FormatInt(usuario.ID, 10),
+			IssuedAt:  jwt.NewNumericDate(agora),
+			ExpiresAt: jwt.NewNumericDate(expiraEm),
+		},
+	}).SignedString(s.segredo)
+	if err != nil {
+		return nil, customErrors.WrapErrorf(err, "servico: falha ao assinar token")
+	}
+	return &entity.Sessao{Token: token, ExpiraEm: expiraEm, Usuario: usuario}, nil
+}
+
+func (s *authService) ValidarToken(ctx context.Context, token string) (*entity.Usuario, error) {
+	var claims claimsToken
+	_, err := jwt.ParseWithClaims(token, &claims, func(*jwt.Token) (interface{}, error) {
+		return s.segredo, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithIssuer(emissorToken), jwt.WithExpirationRequired())
+	if err != nil {
+		return nil, customErrors.WrapErrorf(customErrors.ErrUnauthorized, "servico: token invalido: %v", err)
+	}
+
+	id, err := strconv.ParseInt(claims.Subject, 10, 64)
+	if err != nil {
+		return nil, customErrors.WrapErrorf(customErrors.ErrUnauthorized, "servico: token sem usuario valido")
+	}
+	usuario, err := s.repo.FindByID(ctx, id)
+	if stdErrors.Is(err, customErrors.ErrNotFound) {
+		return nil, customErrors.WrapErrorf(customErrors.ErrUnauthorized, "servico: usuario %d do token nao existe mais", id)
+	}
+	if err != nil {
+		return nil, customErrors.WrapErrorf(err, "servico: falha ao buscar usuario do token")
+	}
+	return usuario, nil
+}
+
+// CriarUsuarioInicial cadastra o primeiro administrador quando a tabela esta vazia,
+// permitindo o primeiro login sem acesso direto ao banco.
+func (s *authService) CriarUsuarioInicial(ctx context.Context, usuario *entity.Usuario) error {
+	total, err := s.repo.Count(ctx)
+	if err != nil {
+		return customErrors.WrapErrorf(err, "servico: falha ao verificar usuarios existentes")
+	}
+	if total > 0 {
+		return nil
+	}
+	usuario.Papel = entity.PapelAdmin
+	return criarUsuario(ctx, s.repo, usuario)
+}
+
+func criarUsuario(ctx context.Context, repo repository.UsuarioRepository, usuario *entity.Usuario) error {
+	if usuario.ID != 0 {
+		return customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: ID do usuario e gerado pelo servidor e nao deve ser informado")
+	}
+	usuario.Login = strings.ToLower(strings.TrimSpace(usuario.Login))
+	usuario.Nome = strings.TrimSpace(usuario.Nome)
+	if !loginValido.MatchString(usuario.Login) {
+		return customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: login deve ter entre 3 e 100 caracteres entre letras minusculas, digitos, '.', '_' e '-'")
+	}
+	if usuario.Nome == "" {
+		usuario.Nome = usuario.Login
+	}
+	if usuario.Papel == "" {
+		usuario.Papel = entity.PapelLeitor
+	}
+	if !usuario.Papel.Valido() {
+		return customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: papel %q invalido, use leitor, editor ou admin", usuario.Papel)
+	}
+	if len(usuario.Nome) > 100 {
+		return customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: nome do usuario deve ter no maximo 100 caracteres")
+	}
+	// bcrypt ignora o que passa de 72 bytes
+	if len(usuario.Senha) < 8 || len(usuario.Senha) > 72 {
+		return customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: senha deve ter entre 8 e 72 bytes")
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(usuario.Senha), bcrypt.DefaultCost)
+	if err != nil {
+		return customErrors.WrapErrorf(err, "servico: falha ao gerar hash da senha")
+	}
+	usuario.SenhaHash = string(hash)
+	usuario.Senha = ""
+
+	if err := repo.Create(ctx, usuario); err != nil {
+		return customErrors.WrapErrorf(err, "servico: falha ao criar usuario")
+	}
+	return nil
+}
@@ -2,39 +2,81 @@ package service
 
 import (
 	"context"
-	stdErrors "errors"
+	"encoding/json"
+	"log"
+	"strings"
+	"time"
+
 	"github.com/robitooS/backend/internal/entity"
 	customErrors "github.com/robitooS/backend/internal/errors"
+	"github.com/robitooS/backend/internal/foto"
 	"github.com/robitooS/backend/internal/repository"
 )
 
+const (
+	LimitePadrao = 50
+	LimiteMaximo = 200
+)
+
 type contatoService struct {
-	repo    repository.ContatoRepository
+	repo  repository.ContatoRepository
+	fotos foto.FotoStorage
 }
 
-func NewContatoService(repo repository.ContatoRepository) ContatoService {
+func NewContatoService(repo repository.ContatoRepository, fotos foto.FotoStorage) ContatoService {
 	return &contatoService{
-		repo: repo, 
+		repo:  repo,
+		fotos: fotos,
 	}
 }
 
 func (s *contatoService) Create(ctx context.Context, contato *entity.Contato) error {
+	if err := validarCriacao(contato); err != nil {
+		return err
+	}
+
+	if err := s.repo.Create(ctx, contato); err != nil {
+		return customErrors.WrapErrorf(err, "servico: falha ao criar contato")
+	}
+	return nil
+}
+
+func validarCriacao(contato *entity.Contato) error {
 	if len(contato.Nome) < 2 {
 		return customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: nome do contato deve ter no minimo 2 caracteres")
 	}
-	if contato.Idade < 0 {
-		return customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: idade do contato nao pode ser negativa")
+	if err := prepararIdade(contato); err != nil {
+		return err
 	}
-	if contato.ID < 0 {
-		return customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: ID do contato nao pode ser negativo")
+	if contato.ID != 0 {
+		return customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: ID do contato e gerado pelo servidor e nao deve ser informado")
 	}
+	if err := normalizarTelefones(contato.Telefones); err != nil {
+		return err
+	}
+	if err := normalizarEmails(contato.Emails); err != nil {
+		return err
+	}
+	return normalizarEnderecos(contato.Enderecos)
+}
 
-	if err := s.repo.Create(ctx, contato); err != nil {
-		if stdErrors.Is(err, customErrors.ErrAlreadyExists) {
-			return customErrors.WrapErrorf(customErrors.ErrAlreadyExists, "servico: contato com ID %d ja existe", contato.ID)
+func prepararIdade(contato *entity.Contato) error {
+	if contato.DataNascimento == nil {
+		if contato.Idade < 0 {
+			return customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: idade do contato nao pode ser negativa")
 		}
-		return customErrors.WrapErrorf(err, "servico: falha ao criar contato")
+		return nil
 	}
+
+	hoje := time.Now()
+	if contato.DataNascimento.After(hoje) || contato.DataNascimento.Year() < 1900 {
+		return customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: data de nascimento %s invalida", contato.DataNascimento)
+	}
+	idade := entity.IdadeEm(*contato.DataNascimento, hoje)
+	if contato.Idade != 0 && contato.Idade != idade {
+		return customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: idade %d nao confere com a data de nascimento %s", contato.Idade, contato.DataNascimento)
+	}
+	contato.Idade = idade
 	return nil
 }
 
@@ -46,12 +88,95 @@ func (s *contatoService) FindAll(ctx context.Context) ([]*entity.Contato, error)
 	return contatos, nil
 }
 
-func (s *contatoService) FindWithFilters(ctx context.Context, nome string, numero string) ([]*entity.Contato, error) {
-	contatos, err := s.repo.FindWithFilters(ctx, nome, numero)
+func (s *contatoService) FindWithFilters(ctx context.Context, filtro entity.FiltroContato, pag entity.Paginacao) (*entity.PaginaContatos, error) {
+	if err := validarPaginacao(&pag); err != nil {
+		return nil, err
+	}
+	if err := prepararFiltro(&filtro); err != nil {
+		return nil, err
+	}
+
+	pagina, err := s.repo.FindWithFilters(ctx, filtro, pag)
 	if err != nil {
 		return nil, customErrors.WrapErrorf(err, "servico: falha ao buscar contatos com filtros")
 	}
-	return contatos, nil
+	return pagina, nil
+}
+
+func validarPaginacao(pag *entity.Paginacao) error {
+	if pag.Limite == 0 {
+		pag.Limite = LimitePadrao
+	}
+	if pag.Limite < 0 || pag.Limite > LimiteMaximo {
+		return customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: limite deve estar entre 1 e %d", LimiteMaximo)
+	}
+	return nil
+}
+
+func (s *contatoService) FindAllWithFilters(ctx context.Context, filtro entity.FiltroContato) ([]*entity.Contato, error) {
+	if err := prepararFiltro(&filtro); err != nil {
+		return nil, err
+	}
+
+	var contatos []*entity.Contato
+	pag := entity.Paginacao{Limite: LimiteMaximo}
+	for {
+		pagina, err := s.repo.FindWithFilters(ctx, filtro, pag)
+		if err != nil {
+			return nil, customErrors.WrapErrorf(err, "servico: falha ao buscar contatos com filtros")
+		}
+		contatos = append(contatos, pagina.Contatos...)
+		if pagina.ProximoCursor == "" {
+			return contatos, nil
+		}
+		pag.Cursor = pagina.ProximoCursor
+	}
+}
+
+func prepararFiltro(filtro *entity.FiltroContato) error {
+	if filtro.Numero != "" {
+		filtro.Numero = strings.TrimLeft(somenteDigitos(filtro.Numero), "0")
+		if filtro.Numero == "" {
+			return customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: filtro numero deve conter digitos")
+		}
+	}
+
+	if filtro.Tipo != "" {
+		if err := validarTipoTelefone(filtro.Tipo); err != nil {
+			return err
+		}
+	}
+
+	filtro.Email = strings.TrimSpace(filtro.Email)
+	filtro.Cidade = strings.TrimSpace(filtro.Cidade)
+	if filtro.UF != "" {
+		uf, err := normalizarUF(filtro.UF)
+		if err != nil {
+			return err
+		}
+		filtro.UF = uf
+	}
+
+	switch filtro.Busca {
+	case "":
+		filtro.Busca = entity.BuscaContem
+	case entity.BuscaContem, entity.BuscaAproximada:
+	default:
+		return customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: modo de busca %q invalido", filtro.Busca)
+	}
+
+	switch filtro.Ordem {
+	case "":
+		filtro.Ordem = entity.OrdenarPorID
+	case entity.OrdenarPorID:
+	case entity.OrdenarPorRelevancia:
+		if filtro.Nome == "" {
+			return customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: ordenacao por relevancia exige o filtro nome")
+		}
+	default:
+		return customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: ordenacao %q invalida", filtro.Ordem)
+	}
+	return nil
 }
 
 func (s *contatoService) FindByID(ctx context.Context, id int64) (*entity.Contato, error) {
@@ -69,19 +194,87 @@ func (s *contatoService) Update(ctx context.Context, contato *entity.Contato) er
 	if len(contato.Nome) < 2 {
 		return customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: nome do contato deve ter no minimo 2 caracteres")
 	}
-	if contato.Idade < 0 {
-		return customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: idade do contato nao pode ser negativa")
+	if err := prepararIdade(contato); err != nil {
+		return err
+	}
+	if err := normalizarTelefones(contato.Telefones); err != nil {
+		return err
+	}
+	if err := normalizarEmails(contato.Emails); err != nil {
+		return err
+	}
+	if err := normalizarEnderecos(contato.Enderecos); err != nil {
+		return err
 	}
 
-	if err := s.repo.Update(ctx, contato); err != nil {
+	if _, err := s.repo.Update(ctx, contato); err != nil {
 		return customErrors.WrapErrorf(err, "servico: falha ao atualizar contato %d", contato.ID)
 	}
 	return nil
 }
 
+func (s *contatoService) Patch(ctx context.Context, id int64, patch []byte, versao int64) (*entity.Contato, error) {
+	atual, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, customErrors.WrapErrorf(err, "servico: falha ao buscar contato %d para atualizacao parcial", id)
+	}
+	if versao != 0 && versao != atual.Versao {
+		return nil, customErrors.WrapErrorf(customErrors.ErrVersionConflict, "servico: contato %d esta na versao %d, esperada %d", id, atual.Versao, versao)
+	}
+
+	original, err := json.Marshal(atual)
+	if err != nil {
+		return nil, customErrors.WrapErrorf(err, "servico: falha ao serializar contato %d", id)
+	}
+
+	mesclado, err := aplicarMergePatch(original, patch)
+	if err != nil {
+		return nil, err
+	}
+
+	var contato entity.Contato
+	if err := json.Unmarshal(mesclado, &contato); err != nil {
+		return nil, customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: documento merge-patch resulta em contato invalido: %v", err)
+	}
+	contato.ID = id
+	contato.Versao = atual.Versao
+	contato.DeletedAt = nil
+	contato.Relevancia = 0
+	if contato.DataNascimento != nil && contato.Idade == atual.Idade {
+		contato.Idade = 0
+	}
+
+	if err := s.Update(ctx, &contato); err != nil {
+		return nil, err
+	}
+	return &contato, nil
+}
+
 func (s *contatoService) Delete(ctx context.Context, id int64) error {
 	if err := s.repo.Delete(ctx, id); err != nil {
 		return customErrors.WrapErrorf(err, "servico: falha ao deletar contato %d", id)
 	}
 	return nil
 }
+
+func (s *contatoService) FindDeleted(ctx context.Context, pag entity.Paginacao) (*entity.PaginaContatos, error) {
+	return s.FindWithFilters(ctx, entity.FiltroContato{Excluidos: true}, pag)
+}
+
+func (s *contatoService) Restore(ctx context.Context, id int64) error {
+	if err := s.repo.Restore(ctx, id); err != nil {
+		return customErrors.WrapErrorf(err, "servico: falha ao restaurar contato %d", id)
+	}
+	return nil
+}
+
+func (s *contatoService) Purge(ctx context.Context, id int64) error {
+	if err := s.repo.Purge(ctx, id); err != nil {
+		return customErrors.WrapErrorf(err, "servico: falha ao expurgar contato %d", id)
+	}
+	// O contato ja foi removido, uma falha aqui apenas deixa arquivos orfaos
+	if err := s.fotos.Remover(ctx, id); err != nil {
+		log.Printf("servico: falha ao remover fotos do contato %d expurgado: %v", id, err)
+	}
+	return nil
+}
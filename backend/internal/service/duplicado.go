@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+	"sort"
+
+	"github.com/robitooS/backend/internal/entity"
+	customErrors "github.com/robitooS/backend/internal/errors"
+)
+
+const (
+	SimilaridadePadrao = 0.6
+	pesoMesmoTelefone  = 0.7
+)
+
+func (s *contatoService) FindDuplicados(ctx context.Context, similaridadeMinima float64) ([]entity.GrupoDuplicados, error) {
+	if similaridadeMinima == 0 {
+		similaridadeMinima = SimilaridadePadrao
+	}
+	if similaridadeMinima < 0 || similaridadeMinima > 1 {
+		return nil, customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: similaridade minima deve estar entre 0 e 1")
+	}
+
+	pares, err := s.repo.FindParesDuplicados(ctx)
+	if err != nil {
+		return nil, customErrors.WrapErrorf(err, "servico: falha ao buscar contatos duplicados")
+	}
+
+	grupos := agruparDuplicados(pares, similaridadeMinima)
+	if len(grupos) == 0 {
+		return []entity.GrupoDuplicados{}, nil
+	}
+
+	var ids []int64
+	for _, grupo := range grupos {
+		ids = append(ids, grupo.ids...)
+	}
+	contatos, err := s.repo.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, customErrors.WrapErrorf(err, "servico: falha ao carregar contatos duplicados")
+	}
+	porID := make(map[int64]*entity.Contato, len(contatos))
+	for _, contato := range contatos {
+		porID[contato.ID] = contato
+	}
+
+	resultado := make([]entity.GrupoDuplicados, 0, len(grupos))
+	for _, grupo := range grupos {
+		saida := entity.GrupoDuplicados{Confianca: grupo.confianca}
+		if grupo.porTelefone {
+			saida.Motivos = append(saida.Motivos, entity.MotivoTelefone)
+		}
+		if grupo.porNome {
+			saida.Motivos = append(saida.Motivos, entity.MotivoNome)
+		}
+		for _, id := range grupo.ids {
+			if contato, ok := porID[id]; ok {
+				saida.Contatos = append(saida.Contatos, contato)
+			}
+		}
+		if len(saida.Contatos) > 1 {
+			resultado = append(resultado, saida)
+		}
+	}
+	return resultado, nil
+}
+
+type grupoDuplicados struct {
+	ids         []int64
+	confianca   float64
+	porTelefone bool
+	porNome     bool
+}
+
+func agruparDuplicados(pares []entity.ParDuplicado, similaridadeMinima float64) []*grupoDuplicados {
+	pai := make(map[int64]int64)
+	var raiz func(id int64) int64
+	raiz = func(id int64) int64 {
+		if p, ok := pai[id]; ok && p != id {
+			pai[id] = raiz(p)
+			return pai[id]
+		}
+		pai[id] = id
+		return id
+	}
+
+	var aceitos []entity.ParDuplicado
+	for _, par := range pares {
+		if !par.MesmoTelefone && par.Similaridade < similaridadeMinima {
+			continue
+		}
+		aceitos = append(aceitos, par)
+		a, b := raiz(par.IDA), raiz(par.IDB)
+		if a != b {
+			pai[b] = a
+		}
+	}
+
+	porRaiz := make(map[int64]*grupoDuplicados)
+	for _, par := range aceitos {
+		r := raiz(par.IDA)
+		grupo, ok := porRaiz[r]
+		if !ok {
+			grupo = &grupoDuplicados{}
+			porRaiz[r] = grupo
+		}
+		if confianca := confiancaDoPar(par); confianca > grupo.confianca {
+			grupo.confianca = confianca
+		}
+		grupo.porTelefone = grupo.porTelefone || par.MesmoTelefone
+		grupo.porNome = grupo.porNome || par.Similaridade >= similaridadeMinima
+	}
+	for id := range pai {
+		if grupo, ok := porRaiz[raiz(id)]; ok {
+			grupo.ids = append(grupo.ids, id)
+		}
+	}
+
+	grupos := make([]*grupoDuplicados, 0, len(porRaiz))
+	for _, grupo := range porRaiz {
+		sort.Slice(grupo.ids, func(i, j int) bool { return grupo.ids[i] < grupo.ids[j] })
+		grupos = append(grupos, grupo)
+	}
+	sort.Slice(grupos, func(i, j int) bool {
+		if grupos[i].confianca != grupos[j].confianca {
+			return grupos[i].confianca > grupos[j].confianca
+		}
+		return grupos[i].ids[0] < grupos[j].ids[0]
+	})
+	return grupos
+}
+
+func confiancaDoPar(par entity.ParDuplicado) float64 {
+	telefone := 0.0
+	if par.MesmoTelefone {
+		telefone = pesoMesmoTelefone
+	}
+	return 1 - (1-telefone)*(1-par.Similaridade)
+}
+
+func (s *contatoService) Mesclar(ctx context.Context, ids []int64, principal int64) (*entity.Contato, error) {
+	if principal == 0 && len(ids) > 0 {
+		principal = ids[0]
+	}
+
+	vistos := make(map[int64]bool, len(ids))
+	var outros []int64
+	for _, id := range ids {
+		if id <= 0 {
+			return nil, customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: ID de contato invalido %d para mesclagem", id)
+		}
+		if vistos[id] {
+			continue
+		}
+		vistos[id] = true
+		if id != principal {
+			outros = append(outros, id)
+		}
+	}
+	if !vistos[principal] {
+		return nil, customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: contato principal %d deve estar entre os contatos mesclados", principal)
+	}
+	if len(outros) == 0 {
+		return nil, customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: informe ao menos dois contatos distintos para mesclar")
+	}
+
+	contato, err := s.repo.Mesclar(ctx, principal, outros)
+	if err != nil {
+		return nil, customErrors.WrapErrorf(err, "servico: falha ao mesclar contatos %v no contato %d", outros, principal)
+	}
+	return contato, nil
+}
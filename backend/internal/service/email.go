@@ -0,0 +1,46 @@
+package service
+
+import (
+	"net/mail"
+	"strings"
+
+	"github.com/robitooS/backend/internal/entity"
+	customErrors "github.com/robitooS/backend/internal/errors"
+)
+
+const tamanhoMaximoEmail = 254
+
+func normalizarEmails(emails []entity.Email) error {
+	vistos := make(map[string]bool, len(emails))
+	for i := range emails {
+		if err := normalizarEmail(&emails[i]); err != nil {
+			return err
+		}
+		chave := strings.ToLower(emails[i].Endereco)
+		if vistos[chave] {
+			return customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: email %q informado mais de uma vez", emails[i].Endereco)
+		}
+		vistos[chave] = true
+	}
+	return nil
+}
+
+func normalizarEmail(email *entity.Email) error {
+	endereco := strings.TrimSpace(email.Endereco)
+	invalido := customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: email %q invalido", endereco)
+
+	if len(endereco) > tamanhoMaximoEmail {
+		return customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: email %q excede %d caracteres", endereco, tamanhoMaximoEmail)
+	}
+	analisado, err := mail.ParseAddress(endereco)
+	if err != nil || analisado.Name != "" || analisado.Address != endereco {
+		return invalido
+	}
+
+	local, dominio, _ := strings.Cut(endereco, "@")
+	if !strings.Contains(dominio, ".") || strings.HasPrefix(dominio, ".") || strings.HasSuffix(dominio, ".") {
+		return invalido
+	}
+	email.Endereco = local + "@" + strings.ToLower(dominio)
+	return nil
+}
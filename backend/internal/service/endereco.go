@@ -0,0 +1,88 @@
+package service
+
+import (
+	"strings"
+
+	"github.com/robitooS/backend/internal/entity"
+	customErrors "github.com/robitooS/backend/internal/errors"
+)
+
+var ufsBrasileiras = map[string]bool{
+	"AC": true, "AL": true, "AP": true, "AM": true, "BA": true, "CE": true, "DF": true,
+	"ES": true, "GO": true, "MA": true, "MT": true, "MS": true, "MG": true, "PA": true,
+	"PB": true, "PR": true, "PE": true, "PI": true, "RJ": true, "RN": true, "RS": true,
+	"RO": true, "RR": true, "SC": true, "SP": true, "SE": true, "TO": true,
+}
+
+func normalizarEnderecos(enderecos []entity.Endereco) error {
+	for i := range enderecos {
+		if err := normalizarEndereco(&enderecos[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func normalizarEndereco(endereco *entity.Endereco) error {
+	endereco.Logradouro = strings.TrimSpace(endereco.Logradouro)
+	endereco.Numero = strings.TrimSpace(endereco.Numero)
+	endereco.Complemento = strings.TrimSpace(endereco.Complemento)
+	endereco.Bairro = strings.TrimSpace(endereco.Bairro)
+	endereco.Cidade = strings.TrimSpace(endereco.Cidade)
+
+	campos := []struct {
+		nome        string
+		valor       string
+		obrigatorio bool
+		maximo      int
+	}{
+		{"logradouro", endereco.Logradouro, true, 200},
+		{"numero", endereco.Numero, false, 20},
+		{"complemento", endereco.Complemento, false, 100},
+		{"bairro", endereco.Bairro, false, 100},
+		{"cidade", endereco.Cidade, true, 100},
+	}
+	for _, campo := range campos {
+		if campo.obrigatorio && campo.valor == "" {
+			return customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: %s do endereco e obrigatorio", campo.nome)
+		}
+		if len(campo.valor) > campo.maximo {
+			return customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: %s do endereco excede %d caracteres", campo.nome, campo.maximo)
+		}
+	}
+
+	uf, err := normalizarUF(endereco.UF)
+	if err != nil {
+		return err
+	}
+	endereco.UF = uf
+
+	cep, err := normalizarCEP(endereco.CEP)
+	if err != nil {
+		return err
+	}
+	endereco.CEP = cep
+	return nil
+}
+
+func normalizarUF(uf string) (string, error) {
+	normalizada := strings.ToUpper(strings.TrimSpace(uf))
+	if !ufsBrasileiras[normalizada] {
+		return "", customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: UF %q invalida", uf)
+	}
+	return normalizada, nil
+}
+
+func normalizarCEP(cep string) (string, error) {
+	invalido := customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: CEP %q invalido, use 8 digitos (ex.: 01310-100)", cep)
+
+	texto := strings.TrimSpace(cep)
+	if strings.Trim(texto, "0123456789-. ") != "" {
+		return "", invalido
+	}
+	digitos := somenteDigitos(texto)
+	if len(digitos) != 8 || digitos == "00000000" {
+		return "", invalido
+	}
+	return digitos, nil
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+
+	customErrors "github.com/robitooS/backend/internal/errors"
+	"github.com/robitooS/backend/internal/foto"
+)
+
+func (s *contatoService) SalvarFoto(ctx context.Context, idContato int64, dados []byte) error {
+	if _, err := s.repo.FindByID(ctx, idContato); err != nil {
+		return customErrors.WrapErrorf(err, "servico: falha ao buscar contato %d para salvar foto", idContato)
+	}
+
+	imagem, err := foto.Processar(dados)
+	if err != nil {
+		return err
+	}
+	if err := s.fotos.Salvar(ctx, idContato, imagem); err != nil {
+		return customErrors.WrapErrorf(err, "servico: falha ao salvar foto do contato %d", idContato)
+	}
+	return nil
+}
+
+func (s *contatoService) AbrirFoto(ctx context.Context, idContato int64, variante foto.Variante) (*foto.Arquivo, error) {
+	if variante != foto.VarianteOriginal && variante != foto.VarianteMiniatura {
+		return nil, customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: tamanho de foto %q invalido, use original ou miniatura", variante)
+	}
+	if _, err := s.repo.FindByID(ctx, idContato); err != nil {
+		return nil, customErrors.WrapErrorf(err, "servico: falha ao buscar contato %d para abrir foto", idContato)
+	}
+
+	arquivo, err := s.fotos.Abrir(ctx, idContato, variante)
+	if err != nil {
+		return nil, customErrors.WrapErrorf(err, "servico: falha ao abrir foto do contato %d", idContato)
+	}
+	return arquivo, nil
+}
+
+func (s *contatoService) RemoverFoto(ctx context.Context, idContato int64) error {
+	if _, err := s.repo.FindByID(ctx, idContato); err != nil {
+		return customErrors.WrapErrorf(err, "servico: falha ao buscar contato %d para remover foto", idContato)
+	}
+	if err := s.fotos.Remover(ctx, idContato); err != nil {
+		return customErrors.WrapErrorf(err, "servico: falha ao remover foto do contato %d", idContato)
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/robitooS/backend/internal/entity"
+	customErrors "github.com/robitooS/backend/internal/errors"
+	"github.com/robitooS/backend/internal/repository"
+)
+
+type grupoService struct {
+	repo repository.GrupoRepository
+}
+
+func NewGrupoService(repo repository.GrupoRepository) GrupoService {
+	return &grupoService{
+		repo: repo,
+	}
+}
+
+func (s *grupoService) Create(ctx context.Context, grupo *entity.Grupo) error {
+	if grupo.ID != 0 {
+		return customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: ID do grupo e gerado pelo servidor e nao deve ser informado")
+	}
+	if err := validarGrupo(grupo); err != nil {
+		return err
+	}
+
+	if err := s.repo.Create(ctx, grupo); err != nil {
+		return customErrors.WrapErrorf(err, "servico: falha ao criar grupo")
+	}
+	return nil
+}
+
+func validarGrupo(grupo *entity.Grupo) error {
+	grupo.Nome = strings.TrimSpace(grupo.Nome)
+	grupo.Descricao = strings.TrimSpace(grupo.Descricao)
+	if len(grupo.Nome) < 2 || len(grupo.Nome) > 100 {
+		return customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: nome do grupo deve ter entre 2 e 100 caracteres")
+	}
+	if len(grupo.Descricao) > 255 {
+		return customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: descricao do grupo deve ter no maximo 255 caracteres")
+	}
+	return nil
+}
+
+func (s *grupoService) FindAll(ctx context.Context) ([]*entity.Grupo, error) {
+	grupos, err := s.repo.FindAll(ctx)
+	if err != nil {
+		return nil, customErrors.WrapErrorf(err, "servico: falha ao buscar grupos")
+	}
+	return grupos, nil
+}
+
+func (s *grupoService) FindByID(ctx context.Context, id int64) (*entity.Grupo, error) {
+	grupo, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, customErrors.WrapErrorf(err, "servico: falha ao buscar grupo %d", id)
+	}
+	return grupo, nil
+}
+
+func (s *grupoService) FindByContato(ctx context.Context, idContato int64) ([]*entity.Grupo, error) {
+	grupos, err := s.repo.FindByContato(ctx, idContato)
+	if err != nil {
+		return nil, customErrors.WrapErrorf(err, "servico: falha ao buscar grupos do contato %d", idContato)
+	}
+	return grupos, nil
+}
+
+func (s *grupoService) Update(ctx context.Context, grupo *entity.Grupo) error {
+	if grupo.ID <= 0 {
+		return customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: ID do grupo deve ser maior que 0 para atualizacao")
+	}
+	if err := validarGrupo(grupo); err != nil {
+		return err
+	}
+
+	if err := s.repo.Update(ctx, grupo); err != nil {
+		return customErrors.WrapErrorf(err, "servico: falha ao atualizar grupo %d", grupo.ID)
+	}
+	return nil
+}
+
+func (s *grupoService) Delete(ctx context.Context, id int64) error {
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return customErrors.WrapErrorf(err, "servico: falha ao excluir grupo %d", id)
+	}
+	return nil
+}
+
+func (s *grupoService) AdicionarContato(ctx context.Context, idGrupo int64, idContato int64) error {
+	if err := s.repo.AdicionarContato(ctx, idGrupo, idContato); err != nil {
+		return customErrors.WrapErrorf(err, "servico: falha ao adicionar contato %d ao grupo %d", idContato, idGrupo)
+	}
+	return nil
+}
+
+func (s *grupoService) RemoverContato(ctx context.Context, idGrupo int64, idContato int64) error {
+	if err := s.repo.RemoverContato(ctx, idGrupo, idContato); err != nil {
+		return customErrors.WrapErrorf(err, "servico: falha ao remover contato %d do grupo %d", idContato, idGrupo)
+	}
+	return nil
+}
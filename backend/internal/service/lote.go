@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+
+	"github.com/robitooS/backend/internal/entity"
+	customErrors "github.com/robitooS/backend/internal/errors"
+)
+
+const TamanhoMaximoLote = 1000
+
+func (s *contatoService) CreateLote(ctx context.Context, contatos []*entity.Contato, atomico bool) ([]error, error) {
+	if len(contatos) == 0 {
+		return nil, customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: lote de contatos vazio")
+	}
+	if len(contatos) > TamanhoMaximoLote {
+		return nil, customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: lote excede o limite de %d contatos", TamanhoMaximoLote)
+	}
+
+	erros := make([]error, len(contatos))
+	invalidos := 0
+	for i, contato := range contatos {
+		if contato.DataNascimento != nil {
+			// A idade de uma exportacao antiga pode estar desatualizada; com a data de nascimento ela e recalculada.
+			contato.Idade = 0
+		}
+		if err := validarCriacao(contato); err != nil {
+			erros[i] = err
+			invalidos++
+		}
+	}
+
+	if !atomico {
+		for i, contato := range contatos {
+			if erros[i] != nil {
+				continue
+			}
+			if err := s.repo.Create(ctx, contato); err != nil {
+				erros[i] = customErrors.WrapErrorf(err, "servico: falha ao criar contato %d do lote", i)
+			}
+		}
+		return erros, nil
+	}
+
+	if invalidos > 0 {
+		return erros, customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: lote contem %d contatos invalidos", invalidos)
+	}
+
+	if indice, err := s.repo.CreateLote(ctx, contatos); err != nil {
+		if indice >= 0 {
+			erros[indice] = err
+		}
+		return erros, customErrors.WrapErrorf(err, "servico: falha ao criar lote de contatos")
+	}
+	return erros, nil
+}
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+
+	customErrors "github.com/robitooS/backend/internal/errors"
+)
+
+func aplicarMergePatch(original []byte, patch []byte) ([]byte, error) {
+	var alvo interface{}
+	if err := decodificarJSON(original, &alvo); err != nil {
+		return nil, customErrors.WrapErrorf(err, "servico: falha ao decodificar documento original")
+	}
+
+	var documento interface{}
+	if err := decodificarJSON(patch, &documento); err != nil {
+		return nil, customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: documento merge-patch invalido: %v", err)
+	}
+	if _, ok := documento.(map[string]interface{}); !ok {
+		return nil, customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: documento merge-patch deve ser um objeto JSON")
+	}
+
+	return json.Marshal(mesclar(alvo, documento))
+}
+
+func mesclar(alvo interface{}, patch interface{}) interface{} {
+	patchObjeto, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	alvoObjeto, ok := alvo.(map[string]interface{})
+	if !ok {
+		alvoObjeto = make(map[string]interface{})
+	}
+
+	for chave, valor := range patchObjeto {
+		if valor == nil {
+			delete(alvoObjeto, chave)
+			continue
+		}
+		alvoObjeto[chave] = mesclar(alvoObjeto[chave], valor)
+	}
+	return alvoObjeto
+}
+
+func decodificarJSON(dados []byte, destino interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(dados))
+	decoder.UseNumber()
+	return decoder.Decode(destino)
+}
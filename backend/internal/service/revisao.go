@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+
+	"github.com/robitooS/backend/internal/entity"
+	customErrors "github.com/robitooS/backend/internal/errors"
+)
+
+func (s *contatoService) FindRevisoes(ctx context.Context, idContato int64) ([]entity.Revisao, error) {
+	revisoes, err := s.repo.FindRevisoes(ctx, idContato)
+	if err != nil {
+		return nil, customErrors.WrapErrorf(err, "servico: falha ao buscar revisoes do contato %d", idContato)
+	}
+	return revisoes, nil
+}
+
+func (s *contatoService) Reverter(ctx context.Context, idContato int64, rev int64, versao int64) (*entity.Contato, error) {
+	revisao, err := s.repo.FindRevisao(ctx, idContato, rev)
+	if err != nil {
+		return nil, customErrors.WrapErrorf(err, "servico: falha ao buscar revisao %d do contato %d", rev, idContato)
+	}
+
+	contato := *revisao.Contato
+	if contato.Emails == nil || contato.Enderecos == nil {
+		// Revisoes anteriores aos emails e enderecos nao trazem esses campos; os atuais sao mantidos.
+		atual, err := s.repo.FindByID(ctx, idContato)
+		if err != nil {
+			return nil, customErrors.WrapErrorf(err, "servico: falha ao buscar contato %d para reverter", idContato)
+		}
+		if contato.Emails == nil {
+			contato.Emails = atual.Emails
+		}
+		if contato.Enderecos == nil {
+			contato.Enderecos = atual.Enderecos
+		}
+	}
+	contato.ID = idContato
+	contato.Versao = versao
+	contato.DeletedAt = nil
+	contato.Relevancia = 0
+	if contato.DataNascimento != nil {
+		contato.Idade = 0
+	}
+
+	if err := s.Update(ctx, &contato); err != nil {
+		return nil, err
+	}
+	return &contato, nil
+}
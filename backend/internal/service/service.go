@@ -4,13 +4,78 @@ import (
 	"context"
 
 	"github.com/robitooS/backend/internal/entity"
+	"github.com/robitooS/backend/internal/foto"
 )
 
 type ContatoService interface {
 	Create(ctx context.Context, contato *entity.Contato) error
+	CreateLote(ctx context.Context, contatos []*entity.Contato, atomico bool) ([]error, error)
 	FindAll(ctx context.Context) ([]*entity.Contato, error)
-	FindWithFilters(ctx context.Context, nome string, numero string) ([]*entity.Contato, error)
+	FindWithFilters(ctx context.Context, filtro entity.FiltroContato, pag entity.Paginacao) (*entity.PaginaContatos, error)
+	FindAllWithFilters(ctx context.Context, filtro entity.FiltroContato) ([]*entity.Contato, error)
 	FindByID(ctx context.Context, id int64) (*entity.Contato, error)
+	FindAniversariantes(ctx context.Context, dias int) ([]entity.Aniversariante, error)
 	Update(ctx context.Context, contato *entity.Contato) error
+	Patch(ctx context.Context, id int64, patch []byte, versao int64) (*entity.Contato, error)
 	Delete(ctx context.Context, id int64) error
+	FindDeleted(ctx context.Context, pag entity.Paginacao) (*entity.PaginaContatos, error)
+	Restore(ctx context.Context, id int64) error
+	Purge(ctx context.Context, id int64) error
+
+	FindTelefones(ctx context.Context, idContato int64) ([]entity.Telefone, error)
+	FindTelefone(ctx context.Context, idContato int64, id int64) (*entity.Telefone, error)
+	CreateTelefone(ctx context.Context, telefone *entity.Telefone) error
+	UpdateTelefone(ctx context.Context, telefone *entity.Telefone) error
+	DeleteTelefone(ctx context.Context, idContato int64, id int64) error
+
+	FindRevisoes(ctx context.Context, idContato int64) ([]entity.Revisao, error)
+	Reverter(ctx context.Context, idContato int64, rev int64, versao int64) (*entity.Contato, error)
+
+	FindDuplicados(ctx context.Context, similaridadeMinima float64) ([]entity.GrupoDuplicados, error)
+	Mesclar(ctx context.Context, ids []int64, principal int64) (*entity.Contato, error)
+
+	SalvarFoto(ctx context.Context, idContato int64, dados []byte) error
+	AbrirFoto(ctx context.Context, idContato int64, variante foto.Variante) (*foto.Arquivo, error)
+	RemoverFoto(ctx context.Context, idContato int64) error
+}
+
+type AuditoriaService interface {
+	FindWithFilters(ctx context.Context, filtro entity.FiltroAuditoria, pag entity.Paginacao) (*entity.PaginaAuditoria, error)
+}
+
+type GrupoService interface {
+	Create(ctx context.Context, grupo *entity.Grupo) error
+	FindAll(ctx context.Context) ([]*entity.Grupo, error)
+	FindByID(ctx context.Context, id int64) (*entity.Grupo, error)
+	FindByContato(ctx context.Context, idContato int64) ([]*entity.Grupo, error)
+	Update(ctx context.Context, grupo *entity.Grupo) error
+	Delete(ctx context.Context, id int64) error
+	AdicionarContato(ctx context.Context, idGrupo int64, idContato int64) error
+	RemoverContato(ctx context.Context, idGrupo int64, idContato int64) error
+}
+
+type AuthService interface {
+	Login(ctx context.Context, credenciais entity.Credenciais) (*entity.Sessao, error)
+	ValidarToken(ctx context.Context, token string) (*entity.Usuario, error)
+	CriarUsuarioInicial(ctx context.Context, usuario *entity.Usuario) error
+}
+
+type UsuarioService interface {
+	Create(ctx context.Context, usuario *entity.Usuario) error
+	FindAll(ctx context.Context) ([]*entity.Usuario, error)
+	FindByID(ctx context.Context, id int64) (*entity.Usuario, error)
+	AlterarPapel(ctx context.Context, id int64, papel entity.Papel) (*entity.Usuario, error)
+}
+
+type AgendaService interface {
+	Create(ctx context.Context, agenda *entity.Agenda) error
+	FindAll(ctx context.Context) ([]*entity.Agenda, error)
+	FindByID(ctx context.Context, id int64) (*entity.Agenda, error)
+	Acessar(ctx context.Context, id int64, escrita bool) (*entity.Agenda, error)
+	Update(ctx context.Context, agenda *entity.Agenda) error
+	Delete(ctx context.Context, id int64) error
+	FindCompartilhamentos(ctx context.Context, idAgenda int64) ([]entity.Compartilhamento, error)
+	Compartilhar(ctx context.Context, compartilhamento *entity.Compartilhamento) error
+	RemoverCompartilhamento(ctx context.Context, idAgenda int64, idUsuario int64) error
+	AdotarAgendasSemDono(ctx context.Context) (int64, error)
 }
@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/robitooS/backend/internal/entity"
+	customErrors "github.com/robitooS/backend/internal/errors"
+)
+
+const codigoPaisPadrao = "55"
+
+func (s *contatoService) FindTelefones(ctx context.Context, idContato int64) ([]entity.Telefone, error) {
+	telefones, err := s.repo.FindTelefones(ctx, idContato)
+	if err != nil {
+		return nil, customErrors.WrapErrorf(err, "servico: falha ao buscar telefones do contato %d", idContato)
+	}
+	return telefones, nil
+}
+
+func (s *contatoService) FindTelefone(ctx context.Context, idContato int64, id int64) (*entity.Telefone, error) {
+	telefone, err := s.repo.FindTelefone(ctx, idContato, id)
+	if err != nil {
+		return nil, customErrors.WrapErrorf(err, "servico: falha ao buscar telefone %d do contato %d", id, idContato)
+	}
+	return telefone, nil
+}
+
+func (s *contatoService) CreateTelefone(ctx context.Context, telefone *entity.Telefone) error {
+	if err := normalizarTelefone(telefone); err != nil {
+		return err
+	}
+	if err := s.repo.CreateTelefone(ctx, telefone); err != nil {
+		return customErrors.WrapErrorf(err, "servico: falha ao criar telefone para o contato %d", telefone.IDContato)
+	}
+	return nil
+}
+
+func (s *contatoService) UpdateTelefone(ctx context.Context, telefone *entity.Telefone) error {
+	if err := normalizarTelefone(telefone); err != nil {
+		return err
+	}
+	if err := s.repo.UpdateTelefone(ctx, telefone); err != nil {
+		return customErrors.WrapErrorf(err, "servico: falha ao atualizar telefone %d do contato %d", telefone.ID, telefone.IDContato)
+	}
+	return nil
+}
+
+func (s *contatoService) DeleteTelefone(ctx context.Context, idContato int64, id int64) error {
+	if err := s.repo.DeleteTelefone(ctx, idContato, id); err != nil {
+		return customErrors.WrapErrorf(err, "servico: falha ao deletar telefone %d do contato %d", id, idContato)
+	}
+	return nil
+}
+
+func normalizarTelefones(telefones []entity.Telefone) error {
+	principais := 0
+	for i := range telefones {
+		if err := normalizarTelefone(&telefones[i]); err != nil {
+			return err
+		}
+		if telefones[i].Principal {
+			principais++
+		}
+	}
+	if principais > 1 {
+		return customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: apenas um telefone pode ser marcado como principal")
+	}
+	return nil
+}
+
+func normalizarTelefone(telefone *entity.Telefone) error {
+	telefone.Numero = strings.TrimSpace(telefone.Numero)
+	if len(telefone.Numero) > 32 {
+		return customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: telefone %q excede 32 caracteres", telefone.Numero)
+	}
+
+	e164, err := normalizarE164(telefone.Numero)
+	if err != nil {
+		return err
+	}
+	telefone.NumeroE164 = e164
+
+	telefone.Rotulo = strings.TrimSpace(telefone.Rotulo)
+	if len(telefone.Rotulo) > 50 {
+		return customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: rotulo do telefone %q excede 50 caracteres", telefone.Numero)
+	}
+
+	if telefone.Tipo == "" {
+		telefone.Tipo = tipoPadrao(e164)
+	}
+	return validarTipoTelefone(telefone.Tipo)
+}
+
+func validarTipoTelefone(tipo entity.TipoTelefone) error {
+	switch tipo {
+	case entity.TelefoneCelular, entity.TelefoneResidencial, entity.TelefoneComercial, entity.TelefoneFax, entity.TelefoneOutro:
+		return nil
+	}
+	return customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: tipo de telefone %q invalido, use celular, residencial, comercial, fax ou outro", tipo)
+}
+
+func tipoPadrao(e164 string) entity.TipoTelefone {
+	nacional := strings.TrimPrefix(e164, "+"+codigoPaisPadrao)
+	if nacional != e164 && len(nacional) == 11 && nacional[2] == '9' {
+		return entity.TelefoneCelular
+	}
+	return entity.TelefoneOutro
+}
+
+func normalizarE164(numero string) (string, error) {
+	invalido := customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: telefone %q invalido", numero)
+
+	digitos := somenteDigitos(numero)
+	internacional := strings.HasPrefix(numero, "+")
+	if !internacional && strings.HasPrefix(digitos, "00") {
+		digitos = digitos[2:]
+		internacional = true
+	}
+
+	if !internacional {
+		digitos = strings.TrimLeft(digitos, "0")
+		if len(digitos) == 10 || len(digitos) == 11 {
+			digitos = codigoPaisPadrao + digitos
+		}
+		if !strings.HasPrefix(digitos, codigoPaisPadrao) {
+			return "", invalido
+		}
+	}
+
+	if strings.HasPrefix(digitos, codigoPaisPadrao) && !numeroBrasileiroValido(digitos[len(codigoPaisPadrao):]) {
+		return "", invalido
+	}
+	if len(digitos) < 8 || len(digitos) > 15 || digitos[0] == '0' {
+		return "", invalido
+	}
+
+	return "+" + digitos, nil
+}
+
+func numeroBrasileiroValido(nacional string) bool {
+	if len(nacional) != 10 && len(nacional) != 11 {
+		return false
+	}
+	if nacional[0] == '0' || nacional[1] == '0' {
+		return false
+	}
+	if len(nacional) == 11 && nacional[2] != '9' {
+		return false
+	}
+	return true
+}
+
+func somenteDigitos(valor string) string {
+	var b strings.Builder
+	for _, r := range valor {
+		if r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+
+	"github.com/robitooS/backend/internal/entity"
+	customErrors "github.com/robitooS/backend/internal/errors"
+	"github.com/robitooS/backend/internal/repository"
+)
+
+type usuarioService struct {
+	repo repository.UsuarioRepository
+}
+
+func NewUsuarioService(repo repository.UsuarioRepository) UsuarioService {
+	return &usuarioService{
+		repo: repo,
+	}
+}
+
+func (s *usuarioService) Create(ctx context.Context, usuario *entity.Usuario) error {
+	return criarUsuario(ctx, s.repo, usuario)
+}
+
+func (s *usuarioService) FindAll(ctx context.Context) ([]*entity.Usuario, error) {
+	usuarios, err := s.repo.FindAll(ctx)
+	if err != nil {
+		return nil, customErrors.WrapErrorf(err, "servico: falha ao listar usuarios")
+	}
+	return usuarios, nil
+}
+
+func (s *usuarioService) FindByID(ctx context.Context, id int64) (*entity.Usuario, error) {
+	usuario, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, customErrors.WrapErrorf(err, "servico: falha ao buscar usuario %d", id)
+	}
+	return usuario, nil
+}
+
+func (s *usuarioService) AlterarPapel(ctx context.Context, id int64, papel entity.Papel) (*entity.Usuario, error) {
+	if !papel.Valido() {
+		return nil, customErrors.WrapErrorf(customErrors.ErrInvalidInput, "servico: papel %q invalido, use leitor, editor ou admin", papel)
+	}
+	if err := s.repo.AlterarPapel(ctx, id, papel); err != nil {
+		return nil, customErrors.WrapErrorf(err, "servico: falha ao alterar papel do usuario %d", id)
+	}
+	return s.FindByID(ctx, id)
+}
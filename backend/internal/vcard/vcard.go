@@ -0,0 +1,218 @@
+package vcard
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/robitooS/backend/internal/entity"
+	"github.com/robitooS/backend/internal/errors"
+)
+
+const (
+	Versao3 = "3.0"
+	Versao4 = "4.0"
+
+	ContentType = "text/vcard; charset=utf-8"
+
+	tamanhoMaximoLinha = 75
+)
+
+func Escrever(w io.Writer, versao string, contatos ...*entity.Contato) error {
+	if versao != Versao3 && versao != Versao4 {
+		return errors.WrapErrorf(errors.ErrInvalidInput, "vcard: versao %q nao suportada", versao)
+	}
+
+	escritor := bufio.NewWriter(w)
+	for _, contato := range contatos {
+		propriedades := []string{
+			"BEGIN:VCARD",
+			"VERSION:" + versao,
+			"FN:" + escapar(contato.Nome),
+			"N:" + nomeEstruturado(contato.Nome),
+		}
+		for _, telefone := range contato.Telefones {
+			propriedades = append(propriedades, propriedadeTelefone(versao, telefone))
+		}
+		propriedades = append(propriedades, "END:VCARD")
+
+		for _, propriedade := range propriedades {
+			if _, err := escritor.WriteString(dobrar(propriedade)); err != nil {
+				return errors.WrapErrorf(err, "vcard: falha ao escrever contato %d", contato.ID)
+			}
+		}
+	}
+	return escritor.Flush()
+}
+
+func Ler(r io.Reader) ([]*entity.Contato, error) {
+	linhas, err := desdobrar(r)
+	if err != nil {
+		return nil, errors.WrapErrorf(errors.ErrInvalidInput, "vcard: falha ao ler arquivo: %v", err)
+	}
+
+	var contatos []*entity.Contato
+	var atual *entity.Contato
+	var nomeN string
+	for numero, linha := range linhas {
+		chave, valor, temValor := strings.Cut(linha, ":")
+		if !temValor {
+			if strings.TrimSpace(linha) == "" {
+				continue
+			}
+			return nil, errors.WrapErrorf(errors.ErrInvalidInput, "vcard: linha %d sem valor", numero+1)
+		}
+		propriedade, params := separarParametros(chave)
+
+		switch propriedade {
+		case "BEGIN":
+			if atual != nil {
+				return nil, errors.WrapErrorf(errors.ErrInvalidInput, "vcard: BEGIN aninhado na linha %d", numero+1)
+			}
+			atual, nomeN = &entity.Contato{}, ""
+		case "END":
+			if atual == nil {
+				return nil, errors.WrapErrorf(errors.ErrInvalidInput, "vcard: END sem BEGIN na linha %d", numero+1)
+			}
+			if atual.Nome == "" {
+				atual.Nome = nomeN
+			}
+			contatos = append(contatos, atual)
+			atual = nil
+		case "FN":
+			if atual != nil {
+				atual.Nome = strings.TrimSpace(desescapar(valor))
+			}
+		case "N":
+			if atual != nil {
+				nomeN = nomeDeN(valor)
+			}
+		case "TEL":
+			if atual != nil {
+				numeroTelefone := strings.TrimSpace(desescapar(valor))
+				if strings.EqualFold(params["VALUE"], "uri") || strings.HasPrefix(strings.ToLower(numeroTelefone), "tel:") {
+					numeroTelefone = numeroTelefone[strings.Index(numeroTelefone, ":")+1:]
+				}
+				if numeroTelefone != "" {
+					atual.Telefones = append(atual.Telefones, entity.Telefone{Numero: numeroTelefone})
+				}
+			}
+		}
+	}
+
+	if atual != nil {
+		return nil, errors.WrapErrorf(errors.ErrInvalidInput, "vcard: arquivo terminou sem END:VCARD")
+	}
+	return contatos, nil
+}
+
+func propriedadeTelefone(versao string, telefone entity.Telefone) string {
+	numero := telefone.NumeroE164
+	if numero == "" {
+		numero = telefone.Numero
+	}
+	if versao == Versao4 {
+		return "TEL;VALUE=uri;TYPE=voice:tel:" + numero
+	}
+	return "TEL;TYPE=VOICE:" + escapar(numero)
+}
+
+func nomeEstruturado(nome string) string {
+	partes := strings.Fields(nome)
+	if len(partes) <= 1 {
+		return fmt.Sprintf("%s;;;;", escapar(nome))
+	}
+	sobrenome := partes[len(partes)-1]
+	prenome := strings.Join(partes[:len(partes)-1], " ")
+	return fmt.Sprintf("%s;%s;;;", escapar(sobrenome), escapar(prenome))
+}
+
+func nomeDeN(valor string) string {
+	componentes := dividirEscapado(valor, ';')
+	var partes []string
+	for _, indice := range []int{3, 1, 2, 0, 4} {
+		if indice < len(componentes) {
+			valores := dividirEscapado(componentes[indice], ',')
+			if parte := strings.TrimSpace(desescapar(strings.Join(valores, " "))); parte != "" {
+				partes = append(partes, parte)
+			}
+		}
+	}
+	return strings.Join(partes, " ")
+}
+
+func separarParametros(chave string) (string, map[string]string) {
+	partes := dividirEscapado(chave, ';')
+	propriedade := strings.ToUpper(partes[0])
+	if _, depois, ok := strings.Cut(propriedade, "."); ok {
+		propriedade = depois
+	}
+
+	params := make(map[string]string, len(partes)-1)
+	for _, parte := range partes[1:] {
+		nome, valor, _ := strings.Cut(parte, "=")
+		params[strings.ToUpper(nome)] = strings.Trim(valor, `"`)
+	}
+	return propriedade, params
+}
+
+func dividirEscapado(valor string, separador byte) []string {
+	var partes []string
+	inicio := 0
+	for i := 0; i < len(valor); i++ {
+		switch valor[i] {
+		case '\\':
+			i++
+		case separador:
+			partes = append(partes, valor[inicio:i])
+			inicio = i + 1
+		}
+	}
+	return append(partes, valor[inicio:])
+}
+
+func desdobrar(r io.Reader) ([]string, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var linhas []string
+	for scanner.Scan() {
+		linha := strings.TrimRight(scanner.Text(), "\r")
+		if len(linhas) > 0 && (strings.HasPrefix(linha, " ") || strings.HasPrefix(linha, "\t")) {
+			linhas[len(linhas)-1] += linha[1:]
+			continue
+		}
+		linhas = append(linhas, strings.TrimPrefix(linha, "\ufeff"))
+	}
+	return linhas, scanner.Err()
+}
+
+func dobrar(linha string) string {
+	var b strings.Builder
+	tamanho := 0
+	for _, r := range linha {
+		largura := len(string(r))
+		if tamanho+largura > tamanhoMaximoLinha {
+			b.WriteString("\r\n ")
+			tamanho = 1
+		}
+		b.WriteRune(r)
+		tamanho += largura
+	}
+	b.WriteString("\r\n")
+	return b.String()
+}
+
+var (
+	escapador    = strings.NewReplacer(`\`, `\\`, ",", `\,`, ";", `\;`, "\r\n", `\n`, "\n", `\n`)
+	desescapador = strings.NewReplacer(`\\`, `\`, `\,`, ",", `\;`, ";", `\n`, "\n", `\N`, "\n")
+)
+
+func escapar(valor string) string {
+	return escapador.Replace(valor)
+}
+
+func desescapar(valor string) string {
+	return desescapador.Replace(valor)
+}
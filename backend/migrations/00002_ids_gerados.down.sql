@@ -0,0 +1,9 @@
+ALTER TABLE Contato ALTER COLUMN ID DROP IDENTITY IF EXISTS;
+
+ALTER TABLE Telefone DROP CONSTRAINT fk_contato_id;
+
+ALTER TABLE Contato ALTER COLUMN ID TYPE NUMERIC(14,0);
+ALTER TABLE Telefone ALTER COLUMN IDCONTATO TYPE NUMERIC(14,0);
+ALTER TABLE Telefone ALTER COLUMN ID TYPE NUMERIC(14,0);
+
+ALTER TABLE Telefone ADD CONSTRAINT fk_contato_id FOREIGN KEY (IDCONTATO) REFERENCES Contato(ID) ON DELETE CASCADE;
@@ -0,0 +1,11 @@
+ALTER TABLE Telefone DROP CONSTRAINT fk_contato_id;
+
+ALTER TABLE Contato ALTER COLUMN ID TYPE BIGINT;
+ALTER TABLE Telefone ALTER COLUMN IDCONTATO TYPE BIGINT;
+ALTER TABLE Telefone ALTER COLUMN ID TYPE BIGINT;
+
+ALTER TABLE Telefone ADD CONSTRAINT fk_contato_id FOREIGN KEY (IDCONTATO) REFERENCES Contato(ID) ON DELETE CASCADE;
+
+ALTER TABLE Contato ALTER COLUMN ID ADD GENERATED BY DEFAULT AS IDENTITY;
+
+SELECT setval(pg_get_serial_sequence('contato', 'id'), COALESCE(MAX(ID), 0) + 1, false) FROM Contato;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_contato_nome_trgm;
+DROP FUNCTION IF EXISTS f_unaccent(TEXT);
+DROP EXTENSION IF EXISTS pg_trgm;
+DROP EXTENSION IF EXISTS unaccent;
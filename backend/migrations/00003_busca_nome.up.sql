@@ -0,0 +1,8 @@
+CREATE EXTENSION IF NOT EXISTS unaccent;
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE OR REPLACE FUNCTION f_unaccent(texto TEXT) RETURNS TEXT
+LANGUAGE sql IMMUTABLE PARALLEL SAFE STRICT AS
+$$ SELECT public.unaccent('public.unaccent', texto) $$;
+
+CREATE INDEX idx_contato_nome_trgm ON Contato USING gin (lower(f_unaccent(NOME)) gin_trgm_ops);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_telefone_numero_e164;
+ALTER TABLE Telefone DROP COLUMN IF EXISTS NUMERO_E164;
+ALTER TABLE Telefone ALTER COLUMN NUMERO TYPE VARCHAR(16);
@@ -0,0 +1,66 @@
+ALTER TABLE Telefone ALTER COLUMN NUMERO TYPE VARCHAR(32);
+ALTER TABLE Telefone ADD COLUMN NUMERO_E164 VARCHAR(16);
+
+-- Espelha normalizarE164 (internal/service/telefone.go); devolve NULL quando o numero e invalido.
+CREATE FUNCTION f_normalizar_e164(numero TEXT) RETURNS TEXT
+LANGUAGE plpgsql IMMUTABLE STRICT AS
+$$
+DECLARE
+    digitos TEXT := regexp_replace(numero, '\D', '', 'g');
+    internacional BOOLEAN := btrim(numero) LIKE '+%';
+    nacional TEXT;
+BEGIN
+    IF NOT internacional AND digitos LIKE '00%' THEN
+        digitos := substr(digitos, 3);
+        internacional := TRUE;
+    END IF;
+
+    IF NOT internacional THEN
+        digitos := ltrim(digitos, '0');
+        IF length(digitos) IN (10, 11) THEN
+            digitos := '55' || digitos;
+        END IF;
+        IF digitos NOT LIKE '55%' THEN
+            RETURN NULL;
+        END IF;
+    END IF;
+
+    IF digitos LIKE '55%' THEN
+        nacional := substr(digitos, 3);
+        IF length(nacional) NOT IN (10, 11)
+            OR substr(nacional, 1, 1) = '0'
+            OR substr(nacional, 2, 1) = '0'
+            OR (length(nacional) = 11 AND substr(nacional, 3, 1) <> '9') THEN
+            RETURN NULL;
+        END IF;
+    END IF;
+
+    IF length(digitos) < 8 OR length(digitos) > 15 OR digitos LIKE '0%' THEN
+        RETURN NULL;
+    END IF;
+
+    RETURN '+' || digitos;
+END
+$$;
+
+UPDATE Telefone SET NUMERO_E164 = f_normalizar_e164(NUMERO);
+
+DO $$
+DECLARE
+    invalidos TEXT;
+BEGIN
+    SELECT string_agg(format('contato %s: %L', IDCONTATO, NUMERO), ', ')
+    INTO invalidos
+    FROM (SELECT IDCONTATO, NUMERO FROM Telefone WHERE NUMERO_E164 IS NULL ORDER BY IDCONTATO, ID LIMIT 20) t;
+
+    IF invalidos IS NOT NULL THEN
+        RAISE EXCEPTION 'telefones que nao podem ser normalizados para E.164, corrija-os antes de migrar: %', invalidos;
+    END IF;
+END
+$$;
+
+DROP FUNCTION f_normalizar_e164(TEXT);
+
+ALTER TABLE Telefone ALTER COLUMN NUMERO_E164 SET NOT NULL;
+
+CREATE INDEX idx_telefone_numero_e164 ON Telefone USING gin (NUMERO_E164 gin_trgm_ops);
@@ -0,0 +1,3 @@
+DELETE FROM Contato WHERE DELETED_AT IS NOT NULL;
+DROP INDEX IF EXISTS idx_contato_deleted_at;
+ALTER TABLE Contato DROP COLUMN IF EXISTS DELETED_AT;
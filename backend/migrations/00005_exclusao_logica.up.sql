@@ -0,0 +1,3 @@
+ALTER TABLE Contato ADD COLUMN DELETED_AT TIMESTAMPTZ;
+
+CREATE INDEX idx_contato_deleted_at ON Contato (DELETED_AT) WHERE DELETED_AT IS NOT NULL;
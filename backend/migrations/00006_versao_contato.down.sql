@@ -0,0 +1 @@
+ALTER TABLE Contato DROP COLUMN IF EXISTS VERSAO;
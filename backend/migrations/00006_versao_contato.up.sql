@@ -0,0 +1 @@
+ALTER TABLE Contato ADD COLUMN VERSAO BIGINT NOT NULL DEFAULT 1;
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS Auditoria (
+    ID BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    DATA TIMESTAMPTZ NOT NULL DEFAULT now(),
+    USUARIO VARCHAR(100) NOT NULL,
+    OPERACAO VARCHAR(20) NOT NULL,
+    IDCONTATO BIGINT NOT NULL,
+    ANTES JSONB,
+    DEPOIS JSONB
+);
+
+CREATE INDEX idx_auditoria_contato ON Auditoria (IDCONTATO, ID);
+CREATE INDEX idx_auditoria_data ON Auditoria (DATA);
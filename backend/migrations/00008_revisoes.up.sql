@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS Revisao (
+    IDCONTATO BIGINT NOT NULL,
+    REVISAO BIGINT NOT NULL,
+    DATA TIMESTAMPTZ NOT NULL DEFAULT now(),
+    USUARIO VARCHAR(100) NOT NULL,
+    CONTATO JSONB NOT NULL,
+    PRIMARY KEY (IDCONTATO, REVISAO),
+    FOREIGN KEY (IDCONTATO) REFERENCES Contato(ID) ON DELETE CASCADE
+);
+
+INSERT INTO Revisao (IDCONTATO, REVISAO, USUARIO, CONTATO)
+SELECT c.ID, c.VERSAO, 'migracao', jsonb_build_object(
+    'id', c.ID,
+    'nome', c.NOME,
+    'idade', c.IDADE,
+    'versao', c.VERSAO,
+    'telefones', COALESCE((
+        SELECT jsonb_agg(jsonb_build_object('id_contato', t.IDCONTATO, 'id', t.ID, 'numero', t.NUMERO, 'numero_e164', t.NUMERO_E164) ORDER BY t.ID)
+        FROM Telefone t WHERE t.IDCONTATO = c.ID
+    ), '[]'::jsonb)
+)
+FROM Contato c;
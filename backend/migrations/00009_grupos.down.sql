@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS ContatoGrupo;
+DROP TABLE IF EXISTS Grupo;
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS Grupo (
+    ID BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    NOME VARCHAR(100) NOT NULL,
+    DESCRICAO VARCHAR(255) NOT NULL DEFAULT ''
+);
+
+CREATE UNIQUE INDEX idx_grupo_nome ON Grupo (lower(NOME));
+
+CREATE TABLE IF NOT EXISTS ContatoGrupo (
+    IDGRUPO BIGINT NOT NULL,
+    IDCONTATO BIGINT NOT NULL,
+    PRIMARY KEY (IDGRUPO, IDCONTATO),
+    FOREIGN KEY (IDGRUPO) REFERENCES Grupo(ID) ON DELETE CASCADE,
+    FOREIGN KEY (IDCONTATO) REFERENCES Contato(ID) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_contatogrupo_contato ON ContatoGrupo (IDCONTATO);
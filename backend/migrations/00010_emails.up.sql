@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS Email (
+    IDCONTATO BIGINT NOT NULL,
+    ID BIGINT NOT NULL,
+    ENDERECO VARCHAR(254) NOT NULL,
+    PRIMARY KEY (IDCONTATO, ID),
+    CONSTRAINT fk_email_contato_id FOREIGN KEY (IDCONTATO) REFERENCES Contato(ID) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_email_endereco ON Email USING gin (lower(ENDERECO) gin_trgm_ops);
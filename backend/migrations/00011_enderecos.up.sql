@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS Endereco (
+    IDCONTATO BIGINT NOT NULL,
+    ID BIGINT NOT NULL,
+    LOGRADOURO VARCHAR(200) NOT NULL,
+    NUMERO VARCHAR(20) NOT NULL DEFAULT '',
+    COMPLEMENTO VARCHAR(100) NOT NULL DEFAULT '',
+    BAIRRO VARCHAR(100) NOT NULL DEFAULT '',
+    CIDADE VARCHAR(100) NOT NULL,
+    UF CHAR(2) NOT NULL,
+    CEP CHAR(8) NOT NULL,
+    PRIMARY KEY (IDCONTATO, ID),
+    CONSTRAINT fk_endereco_contato_id FOREIGN KEY (IDCONTATO) REFERENCES Contato(ID) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_endereco_cidade_uf ON Endereco (UF, lower(f_unaccent(CIDADE)));
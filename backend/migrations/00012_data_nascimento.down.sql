@@ -0,0 +1,5 @@
+UPDATE Contato SET IDADE = date_part('year', age(current_date, DATA_NASCIMENTO)) WHERE DATA_NASCIMENTO IS NOT NULL;
+UPDATE Contato SET IDADE = 0 WHERE IDADE IS NULL;
+ALTER TABLE Contato ALTER COLUMN IDADE SET NOT NULL;
+DROP INDEX IF EXISTS idx_contato_aniversario;
+ALTER TABLE Contato DROP COLUMN IF EXISTS DATA_NASCIMENTO;
@@ -0,0 +1,5 @@
+ALTER TABLE Contato ADD COLUMN DATA_NASCIMENTO DATE;
+ALTER TABLE Contato ALTER COLUMN IDADE DROP NOT NULL;
+
+CREATE INDEX idx_contato_aniversario ON Contato ((EXTRACT(MONTH FROM DATA_NASCIMENTO) * 100 + EXTRACT(DAY FROM DATA_NASCIMENTO)))
+    WHERE DATA_NASCIMENTO IS NOT NULL AND DELETED_AT IS NULL;
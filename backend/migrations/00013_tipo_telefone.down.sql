@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_telefone_tipo;
+DROP INDEX IF EXISTS idx_telefone_principal;
+ALTER TABLE Telefone DROP CONSTRAINT IF EXISTS chk_telefone_tipo;
+ALTER TABLE Telefone DROP COLUMN IF EXISTS PRINCIPAL;
+ALTER TABLE Telefone DROP COLUMN IF EXISTS ROTULO;
+ALTER TABLE Telefone DROP COLUMN IF EXISTS TIPO;
@@ -0,0 +1,14 @@
+ALTER TABLE Telefone ADD COLUMN TIPO VARCHAR(20) NOT NULL DEFAULT 'outro';
+ALTER TABLE Telefone ADD COLUMN ROTULO VARCHAR(50) NOT NULL DEFAULT '';
+ALTER TABLE Telefone ADD COLUMN PRINCIPAL BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE Telefone ADD CONSTRAINT chk_telefone_tipo CHECK (TIPO IN ('celular', 'residencial', 'comercial', 'fax', 'outro'));
+
+UPDATE Telefone SET TIPO = 'celular' WHERE NUMERO_E164 ~ '^\+55[1-9]{2}9[0-9]{8}$';
+
+UPDATE Telefone t SET PRINCIPAL = TRUE
+FROM (SELECT IDCONTATO, MIN(ID) AS ID FROM Telefone GROUP BY IDCONTATO) primeiro
+WHERE t.IDCONTATO = primeiro.IDCONTATO AND t.ID = primeiro.ID;
+
+CREATE UNIQUE INDEX idx_telefone_principal ON Telefone (IDCONTATO) WHERE PRINCIPAL;
+CREATE INDEX idx_telefone_tipo ON Telefone (TIPO, IDCONTATO);
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS Usuario (
+    ID BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    LOGIN VARCHAR(100) NOT NULL,
+    NOME VARCHAR(100) NOT NULL,
+    SENHA_HASH VARCHAR(72) NOT NULL,
+    CRIADO_EM TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX idx_usuario_login ON Usuario (lower(LOGIN));
@@ -0,0 +1,2 @@
+ALTER TABLE Usuario DROP CONSTRAINT IF EXISTS chk_usuario_papel;
+ALTER TABLE Usuario DROP COLUMN IF EXISTS PAPEL;
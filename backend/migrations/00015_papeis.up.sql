@@ -0,0 +1,6 @@
+ALTER TABLE Usuario ADD COLUMN PAPEL VARCHAR(10) NOT NULL DEFAULT 'leitor';
+ALTER TABLE Usuario ADD CONSTRAINT chk_usuario_papel CHECK (PAPEL IN ('leitor', 'editor', 'admin'));
+
+-- Usuarios existentes ja podiam alterar a agenda; o mais antigo passa a administra-la
+UPDATE Usuario SET PAPEL = 'editor';
+UPDATE Usuario SET PAPEL = 'admin' WHERE ID = (SELECT MIN(ID) FROM Usuario);
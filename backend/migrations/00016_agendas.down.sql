@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_contato_agenda;
+ALTER TABLE Contato DROP CONSTRAINT IF EXISTS fk_contato_agenda;
+ALTER TABLE Contato DROP COLUMN IF EXISTS IDAGENDA;
+DROP TABLE IF EXISTS AgendaCompartilhamento;
+DROP TABLE IF EXISTS Agenda;
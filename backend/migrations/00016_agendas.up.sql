@@ -0,0 +1,41 @@
+CREATE TABLE IF NOT EXISTS Agenda (
+    ID BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    NOME VARCHAR(100) NOT NULL,
+    IDDONO BIGINT,
+    CRIADA_EM TIMESTAMPTZ NOT NULL DEFAULT now(),
+    FOREIGN KEY (IDDONO) REFERENCES Usuario(ID)
+);
+
+CREATE INDEX idx_agenda_dono ON Agenda (IDDONO);
+
+CREATE TABLE IF NOT EXISTS AgendaCompartilhamento (
+    IDAGENDA BIGINT NOT NULL,
+    IDUSUARIO BIGINT NOT NULL,
+    PERMISSAO VARCHAR(10) NOT NULL CHECK (PERMISSAO IN ('leitura', 'escrita')),
+    PRIMARY KEY (IDAGENDA, IDUSUARIO),
+    FOREIGN KEY (IDAGENDA) REFERENCES Agenda(ID) ON DELETE CASCADE,
+    FOREIGN KEY (IDUSUARIO) REFERENCES Usuario(ID) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_compartilhamento_usuario ON AgendaCompartilhamento (IDUSUARIO);
+
+ALTER TABLE Contato ADD COLUMN IDAGENDA BIGINT;
+
+-- Os contatos existentes formam a agenda geral, do administrador mais antigo e compartilhada
+-- com os demais usuarios conforme o papel. Sem usuarios, o dono fica vazio ate o primeiro
+-- administrador ser criado.
+INSERT INTO Agenda (NOME, IDDONO)
+SELECT 'Agenda geral', (SELECT MIN(ID) FROM Usuario WHERE PAPEL = 'admin')
+WHERE EXISTS (SELECT 1 FROM Contato);
+
+UPDATE Contato SET IDAGENDA = (SELECT MIN(ID) FROM Agenda);
+
+INSERT INTO AgendaCompartilhamento (IDAGENDA, IDUSUARIO, PERMISSAO)
+SELECT a.ID, u.ID, CASE WHEN u.PAPEL = 'leitor' THEN 'leitura' ELSE 'escrita' END
+FROM Agenda a CROSS JOIN Usuario u
+WHERE a.IDDONO IS DISTINCT FROM u.ID;
+
+ALTER TABLE Contato ALTER COLUMN IDAGENDA SET NOT NULL;
+ALTER TABLE Contato ADD CONSTRAINT fk_contato_agenda FOREIGN KEY (IDAGENDA) REFERENCES Agenda(ID);
+
+CREATE INDEX idx_contato_agenda ON Contato (IDAGENDA, ID);
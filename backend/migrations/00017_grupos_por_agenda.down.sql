@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_grupo_agenda_nome;
+ALTER TABLE Grupo DROP CONSTRAINT IF EXISTS fk_grupo_agenda;
+ALTER TABLE Grupo DROP COLUMN IF EXISTS IDAGENDA;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_grupo_nome ON Grupo (lower(NOME));
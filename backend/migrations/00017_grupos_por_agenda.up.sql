@@ -0,0 +1,30 @@
+ALTER TABLE Grupo ADD COLUMN IDAGENDA BIGINT;
+
+DROP INDEX IF EXISTS idx_grupo_nome;
+
+-- Cada grupo passa para a agenda de seus membros. Um grupo com membros em varias agendas
+-- fica na de menor ID e e copiado, com os respectivos membros, para cada uma das demais.
+UPDATE Grupo g SET IDAGENDA = (
+    SELECT MIN(c.IDAGENDA) FROM ContatoGrupo cg JOIN Contato c ON c.ID = cg.IDCONTATO WHERE cg.IDGRUPO = g.ID
+);
+
+INSERT INTO Grupo (NOME, DESCRICAO, IDAGENDA)
+SELECT DISTINCT g.NOME, g.DESCRICAO, c.IDAGENDA
+FROM Grupo g
+JOIN ContatoGrupo cg ON cg.IDGRUPO = g.ID
+JOIN Contato c ON c.ID = cg.IDCONTATO
+WHERE c.IDAGENDA <> g.IDAGENDA;
+
+UPDATE ContatoGrupo cg SET IDGRUPO = copia.ID
+FROM Grupo g, Contato c, Grupo copia
+WHERE g.ID = cg.IDGRUPO AND c.ID = cg.IDCONTATO AND c.IDAGENDA <> g.IDAGENDA
+  AND copia.IDAGENDA = c.IDAGENDA AND lower(copia.NOME) = lower(g.NOME);
+
+-- Grupos sem membros vao para a agenda mais antiga; sem nenhuma agenda, sao descartados.
+UPDATE Grupo SET IDAGENDA = (SELECT MIN(ID) FROM Agenda) WHERE IDAGENDA IS NULL;
+DELETE FROM Grupo WHERE IDAGENDA IS NULL;
+
+ALTER TABLE Grupo ALTER COLUMN IDAGENDA SET NOT NULL;
+ALTER TABLE Grupo ADD CONSTRAINT fk_grupo_agenda FOREIGN KEY (IDAGENDA) REFERENCES Agenda(ID) ON DELETE CASCADE;
+
+CREATE UNIQUE INDEX idx_grupo_agenda_nome ON Grupo (IDAGENDA, lower(NOME));
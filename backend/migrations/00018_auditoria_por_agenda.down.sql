@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_auditoria_agenda;
+ALTER TABLE Auditoria DROP COLUMN IF EXISTS IDAGENDA;
@@ -0,0 +1,11 @@
+ALTER TABLE Auditoria ADD COLUMN IDAGENDA BIGINT;
+
+-- Registros de contatos ja expurgados usam a agenda gravada no instantaneo; os anteriores
+-- as agendas ficam na agenda mais antiga, que recebeu todos os contatos existentes.
+UPDATE Auditoria a SET IDAGENDA = COALESCE(
+    (SELECT c.IDAGENDA FROM Contato c WHERE c.ID = a.IDCONTATO),
+    NULLIF(COALESCE(a.DEPOIS, a.ANTES)->>'id_agenda', '0')::bigint,
+    (SELECT MIN(ID) FROM Agenda)
+);
+
+CREATE INDEX idx_auditoria_agenda ON Auditoria (IDAGENDA, ID);
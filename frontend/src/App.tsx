@@ -1,9 +1,10 @@
 import { useState, useEffect } from 'react';
 import { ContactList } from './components/ContactList';
 import { ContactForm } from './components/ContactForm';
-import { contactService } from './services/api';
-import { type Contato, type APIError } from './types';
-import { Search, UserPlus } from 'lucide-react';
+import { LoginForm } from './components/LoginForm';
+import { agendaService, authService, contactService } from './services/api';
+import { type Agenda, type Contato, type APIError } from './types';
+import { LogOut, Search, UserPlus } from 'lucide-react';
 import './App.css';
 
 function App() {
@@ -20,23 +21,81 @@ function App() {
   const [searchName, setSearchName] = useState('');
   const [searchPhone, setSearchPhone] = useState('');
   const [loading, setLoading] = useState(false);
+  const [nextCursor, setNextCursor] = useState<string | null>(null);
+  const [autenticado, setAutenticado] = useState(authService.autenticado());
+  const [agendas, setAgendas] = useState<Agenda[]>([]);
+  const [agendaId, setAgendaId] = useState<number | null>(null);
+
+  const fetchAgendas = async () => {
+    try {
+      let response = await agendaService.list();
+      if (response.data.length === 0) {
+        // Primeiro acesso: cria a agenda pessoal do usuario
+        await agendaService.create('Minha agenda');
+        response = await agendaService.list();
+      }
+      setAgendas(response.data);
+      setAgendaId(response.data.length > 0 ? response.data[0].id : null);
+    } catch (error) {
+      console.warn('Nao foi possivel carregar as agendas.');
+      setAgendas([]);
+      setAgendaId(null);
+    }
+  };
 
   const fetchContacts = async () => {
+    if (agendaId === null) {
+      setContacts([]);
+      setNextCursor(null);
+      return;
+    }
     setLoading(true);
     try {
-      const response = await contactService.list(searchName, searchPhone);
+      const response = await contactService.list(agendaId, searchName, searchPhone);
       setContacts(response.data || []); // Garante que seja um array, mesmo que response.data seja null/undefined
+      setNextCursor(response.headers['x-next-cursor'] || null);
     } catch (error) {
       console.warn('Backend offline ou erro ao buscar contatos, usando array vazio.');
       setContacts([]); // Define como array vazio em caso de erro
+      setNextCursor(null);
     } finally {
       setLoading(false);
     }
   };
 
+  const loadMoreContacts = async () => {
+    if (agendaId === null || !nextCursor) {
+      return;
+    }
+    try {
+      const response = await contactService.list(agendaId, searchName, searchPhone, nextCursor);
+      setContacts([...contacts, ...(response.data || [])]);
+      setNextCursor(response.headers['x-next-cursor'] || null);
+    } catch (error) {
+      console.warn('Erro ao carregar mais contatos.');
+    }
+  };
+
   useEffect(() => {
-    fetchContacts();
-  }, []);
+    if (autenticado) {
+      fetchAgendas();
+    }
+  }, [autenticado]);
+
+  useEffect(() => {
+    if (autenticado) {
+      fetchContacts();
+    }
+  }, [agendaId]);
+
+  const handleLogout = () => {
+    authService.logout();
+    setAutenticado(false);
+  };
+
+  if (!autenticado) {
+    return <LoginForm onLogin={() => setAutenticado(true)} />;
+  }
 
   const handleSearch = (e: React.FormEvent) => {
     e.preventDefault();
@@ -45,10 +104,13 @@ function App() {
 
   const handleSaveContact = async (contact: Contato) => {
     try {
-      if (editingContact) {
-        await contactService.update(contact.id, contact);
+      if (agendaId === null) {
+        return;
+      }
+      if (editingContact && editingContact.id) {
+        await contactService.update(agendaId, editingContact.id, contact);
       } else {
-        await contactService.create(contact);
+        await contactService.create(agendaId, contact);
       }
       setIsFormOpen(false);
       setEditingContact(null);
@@ -67,10 +129,13 @@ function App() {
     }
   };
 
-  const handleDeleteContact = async (id: number) => {
+  const handleDeleteContact = async (id?: number) => {
+    if (!id || agendaId === null) {
+      return;
+    }
     if (window.confirm('Tem certeza que deseja excluir este contato?')) {
       try {
-        await contactService.delete(id);
+        await contactService.delete(agendaId, id);
         fetchContacts();
       } catch (error: any) {
         console.error('Erro ao excluir contato:', error);
@@ -101,9 +166,21 @@ function App() {
     <div className="app-container">
       <header className="app-header">
         <h1>Agenda Telefônica</h1>
+        {agendas.length > 1 && (
+          <select value={agendaId ?? ''} onChange={(e) => setAgendaId(Number(e.target.value))}>
+            {agendas.map((agenda) => (
+              <option key={agenda.id} value={agenda.id}>
+                {agenda.nome}{agenda.permissao === 'leitura' ? ' (somente leitura)' : ''}
+              </option>
+            ))}
+          </select>
+        )}
         <button onClick={openCreateForm} className="btn-primary">
           <UserPlus size={20} /> Novo Contato
         </button>
+        <button onClick={handleLogout} className="btn-secondary">
+          <LogOut size={20} /> Sair
+        </button>
       </header>
 
       <main className="app-content">
@@ -136,11 +213,18 @@ function App() {
         {loading ? (
           <div className="loading">Carregando...</div>
         ) : (
-          <ContactList 
-            contacts={contacts} 
-            onEdit={openEditForm} 
-            onDelete={handleDeleteContact} 
-          />
+          <>
+            <ContactList 
+              contacts={contacts} 
+              onEdit={openEditForm} 
+              onDelete={handleDeleteContact} 
+            />
+            {nextCursor && (
+              <button onClick={loadMoreContacts} className="btn-secondary">
+                Carregar mais
+              </button>
+            )}
+          </>
         )}
       </main>
 
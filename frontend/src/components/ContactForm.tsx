@@ -12,17 +12,14 @@ export const ContactForm: React.FC<ContactFormProps> = ({ contact, onSave, onCan
   const [nome, setNome] = useState('');
   const [idade, setIdade] = useState('');
   const [telefones, setTelefones] = useState<Telefone[]>([]);
-  const [id, setId] = useState('');
   const [errors, setErrors] = useState<{ [key: string]: string }>({});
 
   useEffect(() => {
     if (contact) {
-      setId(contact.id.toString());
       setNome(contact.nome);
       setIdade(contact.idade.toString());
       setTelefones(contact.telefones || []);
     } else {
-      setId('');
       setNome('');
       setIdade('');
       setTelefones([]);
@@ -31,7 +28,7 @@ export const ContactForm: React.FC<ContactFormProps> = ({ contact, onSave, onCan
   }, [contact]);
 
   const addTelefone = () => {
-    setTelefones([...telefones, { id: Date.now(), numero: '' }]);
+    setTelefones([...telefones, { id: 0, numero: '' }]);
   };
 
   const removeTelefone = (index: number) => {
@@ -53,9 +50,6 @@ export const ContactForm: React.FC<ContactFormProps> = ({ contact, onSave, onCan
     if (!idade || Number(idade) < 0) {
       newErrors.idade = 'Idade não pode ser negativa.';
     }
-    if (!id || Number(id) <= 0 || !Number.isInteger(Number(id))) {
-        newErrors.id = 'ID deve ser um número inteiro positivo.';
-    }
 
     // Validação para telefones
     telefones.forEach((tel, index) => {
@@ -74,10 +68,14 @@ export const ContactForm: React.FC<ContactFormProps> = ({ contact, onSave, onCan
       return;
     }
     onSave({
-      id: Number(id),
+      id: contact?.id,
+      versao: contact?.versao,
       nome,
       idade: Number(idade),
-      telefones: telefones.map(t => ({ ...t, id_contato: Number(id) }))
+      data_nascimento: contact?.data_nascimento,
+      telefones,
+      emails: contact?.emails,
+      enderecos: contact?.enderecos
     });
   };
 
@@ -100,17 +98,6 @@ export const ContactForm: React.FC<ContactFormProps> = ({ contact, onSave, onCan
           <button onClick={onCancel} className="btn-icon"><X /></button>
         </div>
         <form onSubmit={handleSubmit}>
-          <div className="form-group">
-            <label>ID (Numérico)</label>
-            <input 
-              type="number" 
-              value={id} 
-              onChange={(e) => setId(e.target.value)} 
-              required 
-              disabled={!!contact}
-            />
-            {errors.id && <span className="error-message">{errors.id}</span>}
-          </div>
           <div className="form-group">
             <label>Nome</label>
             <input 
@@ -127,6 +114,8 @@ export const ContactForm: React.FC<ContactFormProps> = ({ contact, onSave, onCan
               type="number" 
               value={idade} 
               onChange={(e) => setIdade(e.target.value)} 
+              readOnly={!!contact?.data_nascimento}
+              title={contact?.data_nascimento ? 'Calculada a partir da data de nascimento' : undefined}
               required 
             />
             {errors.idade && <span className="error-message">{errors.idade}</span>}
@@ -140,7 +129,7 @@ export const ContactForm: React.FC<ContactFormProps> = ({ contact, onSave, onCan
               </button>
             </div>
             {telefones.map((tel, index) => (
-              <div key={tel.id} className="phone-input-group">
+              <div key={index} className="phone-input-group">
                 <input 
                   type="text" 
                   placeholder="Número" 
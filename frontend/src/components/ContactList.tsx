@@ -5,7 +5,7 @@ import { Edit2, Trash2, Phone } from 'lucide-react';
 interface ContactListProps {
   contacts: Contato[];
   onEdit: (contact: Contato) => void;
-  onDelete: (id: number) => void;
+  onDelete: (id?: number) => void;
 }
 
 export const ContactList: React.FC<ContactListProps> = ({ contacts, onEdit, onDelete }) => {
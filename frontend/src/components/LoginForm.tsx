@@ -0,0 +1,62 @@
+import React, { useState } from 'react';
+import { authService } from '../services/api';
+import { type APIError } from '../types';
+import { LogIn } from 'lucide-react';
+
+interface LoginFormProps {
+  onLogin: () => void;
+}
+
+export const LoginForm: React.FC<LoginFormProps> = ({ onLogin }) => {
+  const [login, setLogin] = useState('');
+  const [senha, setSenha] = useState('');
+  const [erro, setErro] = useState('');
+
+  const handleSubmit = async (e: React.FormEvent) => {
+    e.preventDefault();
+    setErro('');
+    try {
+      await authService.login(login, senha);
+      onLogin();
+    } catch (error: any) {
+      const apiError = error.response?.data as APIError | undefined;
+      setErro(apiError?.message || 'Nao foi possivel entrar.');
+    }
+  };
+
+  return (
+    <div className="modal-overlay">
+      <div className="modal-content">
+        <div className="modal-header">
+          <h2>Entrar</h2>
+        </div>
+        <form onSubmit={handleSubmit}>
+          <div className="form-group">
+            <label>Login</label>
+            <input
+              type="text"
+              value={login}
+              onChange={(e) => setLogin(e.target.value)}
+              required
+            />
+          </div>
+          <div className="form-group">
+            <label>Senha</label>
+            <input
+              type="password"
+              value={senha}
+              onChange={(e) => setSenha(e.target.value)}
+              required
+            />
+            {erro && <span className="error-message">{erro}</span>}
+          </div>
+          <div className="form-actions">
+            <button type="submit" className="btn-primary">
+              <LogIn size={20} /> Entrar
+            </button>
+          </div>
+        </form>
+      </div>
+    </div>
+  );
+};
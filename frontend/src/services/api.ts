@@ -1,23 +1,63 @@
 import axios from 'axios';
-import { type Contato } from '../types';
+import { type Agenda, type Contato, type Sessao } from '../types';
 
 const api = axios.create({
   baseURL: 'http://localhost:8080',
 });
 
+const TOKEN_KEY = 'agenda.token';
+
+api.interceptors.request.use((config) => {
+  const token = localStorage.getItem(TOKEN_KEY);
+  if (token) {
+    config.headers.Authorization = `Bearer ${token}`;
+  }
+  return config;
+});
+
+export const authService = {
+  login: async (login: string, senha: string) => {
+    const response = await api.post<Sessao>('/auth/login', { login, senha });
+    localStorage.setItem(TOKEN_KEY, response.data.token);
+    return response.data;
+  },
+
+  logout: () => localStorage.removeItem(TOKEN_KEY),
+
+  autenticado: () => localStorage.getItem(TOKEN_KEY) !== null,
+};
+
+// Token expirado ou invalido: descarta e volta para o login
+api.interceptors.response.use(undefined, (error) => {
+  if (error.response?.status === 401 && localStorage.getItem(TOKEN_KEY)) {
+    authService.logout();
+    window.location.reload();
+  }
+  return Promise.reject(error);
+});
+
 export const contactService = {
-  list: (nome?: string, numero?: string) => 
-    api.get<Contato[]>('/contatos', { params: { nome, numero } }),
+  // A listagem e paginada; o cursor da proxima pagina vem no cabecalho X-Next-Cursor
+  list: (agendaId: number, nome?: string, numero?: string, cursor?: string) => 
+    api.get<Contato[]>(`/agendas/${agendaId}/contatos`, { params: { nome, numero, cursor } }),
   
-  get: (id: number) => 
-    api.get<Contato>(`/contatos/${id}`),
+  get: (agendaId: number, id: number) => 
+    api.get<Contato>(`/agendas/${agendaId}/contatos/${id}`),
   
-  create: (contato: Contato) => 
-    api.post<Contato>('/contatos', contato),
+  create: (agendaId: number, contato: Contato) => 
+    api.post<Contato>(`/agendas/${agendaId}/contatos`, contato),
   
-  update: (id: number, contato: Contato) => 
-    api.put<Contato>(`/contatos/${id}`, contato),
+  update: (agendaId: number, id: number, contato: Contato) => 
+    api.put<Contato>(`/agendas/${agendaId}/contatos/${id}`, contato, {
+      headers: contato.versao ? { 'If-Match': `"${contato.versao}"` } : undefined,
+    }),
   
-  delete: (id: number) => 
-    api.delete(`/contatos/${id}`),
+  delete: (agendaId: number, id: number) => 
+    api.delete(`/agendas/${agendaId}/contatos/${id}`),
+};
+
+export const agendaService = {
+  list: () => api.get<Agenda[]>('/agendas'),
+
+  create: (nome: string) => api.post<Agenda>('/agendas', { nome }),
 };
@@ -2,13 +2,39 @@ export interface Telefone {
   id_contato?: number;
   id: number;
   numero: string;
+  numero_e164?: string;
+  tipo?: 'celular' | 'residencial' | 'comercial' | 'fax' | 'outro';
+  rotulo?: string;
+  principal?: boolean;
 }
 
-export interface Contato {
+export interface Email {
+  id_contato?: number;
+  id: number;
+  endereco: string;
+}
+
+export interface Endereco {
+  id_contato?: number;
   id: number;
+  logradouro: string;
+  numero: string;
+  complemento: string;
+  bairro: string;
+  cidade: string;
+  uf: string;
+  cep: string;
+}
+
+export interface Contato {
+  id?: number;
   nome: string;
   idade: number;
+  data_nascimento?: string;
+  versao?: number;
   telefones: Telefone[];
+  emails?: Email[];
+  enderecos?: Endereco[];
 }
 
 export interface APIError {
@@ -16,3 +42,23 @@ export interface APIError {
   message: string;
   details?: string[];
 }
+
+export interface Usuario {
+  id: number;
+  login: string;
+  nome: string;
+  papel: 'leitor' | 'editor' | 'admin';
+}
+
+export interface Sessao {
+  token: string;
+  expira_em: string;
+  usuario: Usuario;
+}
+
+export interface Agenda {
+  id: number;
+  nome: string;
+  id_dono: number;
+  permissao: 'dono' | 'escrita' | 'leitura';
+}
@@ -2,8 +2,11 @@
 
 # Script para testar a API da Agenda Telefônica
 # Certifique-se de que a aplicação Go esteja rodando antes de executar.
+# Usa as credenciais ADMIN_LOGIN/ADMIN_SENHA do usuário inicial.
 
 BASE_URL="http://localhost:8080"
+ADMIN_LOGIN="${ADMIN_LOGIN:-admin}"
+ADMIN_SENHA="${ADMIN_SENHA:?defina ADMIN_SENHA}"
 LOG_FILE="backend/logs/exclusao.log"
 TEST_COUNT=0
 FAIL_COUNT=0
@@ -32,26 +35,55 @@ assert_status() {
 # Limpa o log de exclusão para um novo teste
 rm -f $LOG_FILE
 
+# --- Autenticação ---
+TOKEN=$(curl -s -X POST "$BASE_URL/auth/login" \
+-H "Content-Type: application/json" \
+-d "{\"login\": \"$ADMIN_LOGIN\", \"senha\": \"$ADMIN_SENHA\"}" | sed -nE 's/^\{"token":"([^"]+)".*/\1/p')
+if [ -z "$TOKEN" ]; then
+    echo "❌  Falha no login de '$ADMIN_LOGIN' em $BASE_URL/auth/login."
+    exit 1
+fi
+AUTH=(-H "Authorization: Bearer $TOKEN")
+
+# Usa a primeira agenda em que o usuário pode escrever, criando uma se não houver
+AGENDA_ID=$(curl -s "${AUTH[@]}" "$BASE_URL/agendas" \
+| grep -oE '\{"id":[0-9]+,[^}]*"permissao":"(dono|escrita)"' | head -n 1 | sed -E 's/^\{"id":([0-9]+).*/\1/')
+if [ -z "$AGENDA_ID" ]; then
+    AGENDA_ID=$(curl -s "${AUTH[@]}" -X POST "$BASE_URL/agendas" \
+    -H "Content-Type: application/json" \
+    -d '{"nome": "Testes da API"}' | sed -nE 's/^\{"id":([0-9]+).*/\1/p')
+fi
+if [ -z "$AGENDA_ID" ]; then
+    echo "❌  Falha ao obter ou criar uma agenda em $BASE_URL/agendas."
+    exit 1
+fi
+CONTATOS_URL="$BASE_URL/agendas/$AGENDA_ID/contatos"
+
 # --- Início dos Testes ---
 
+# 0. Acessar sem Token
+print_test_name "Listar Contatos sem Token (Erro 401)"
+response_code=$(curl -s -o /dev/null -w "%{http_code}" "$CONTATOS_URL")
+assert_status 401 "$response_code" "Listar Contatos sem Token (Erro 401)"
+
 # 1. Criar um Contato com sucesso
 print_test_name "Criar Contato (Sucesso)"
-response_code=$(curl -s -o /dev/null -w "%{http_code}" -X POST "$BASE_URL/contatos" \
+response=$(curl -s "${AUTH[@]}" -w "\n%{http_code}" -X POST "$CONTATOS_URL" \
 -H "Content-Type: application/json" \
 -d '{
-    "id": 101,
     "nome": "Fulano de Tal",
     "idade": 25,
-    "telefones": [{"id_contato": 101, "id": 1, "numero": "99999-0001"}]
+    "telefones": [{"numero": "(11) 99999-0001"}]
 }')
+response_code=$(echo "$response" | tail -n 1)
+CONTATO_ID=$(echo "$response" | head -n 1 | sed -E 's/^\{"id":([0-9]+).*/\1/')
 assert_status 201 "$response_code" "Criar Contato (Sucesso)"
 
 # 2. Forçar Erro de Requisição Inválida (JSON mal formatado)
 print_test_name "Criar Contato (Erro 400 - Requisição Inválida)"
-response_code=$(curl -s -o /dev/null -w "%{http_code}" -X POST "$BASE_URL/contatos" \
+response_code=$(curl -s "${AUTH[@]}" -o /dev/null -w "%{http_code}" -X POST "$CONTATOS_URL" \
 -H "Content-Type: application/json" \
 -d '{
-    "id": 102,
     "nome": "Ciclano",
     "idade": "idade_invalida"
 }')
@@ -59,54 +91,54 @@ assert_status 400 "$response_code" "Criar Contato (Erro 400 - Requisição Invá
 
 # 3. Listar Contatos
 print_test_name "Listar Todos os Contatos"
-response_code=$(curl -s -o /dev/null -w "%{http_code}" "$BASE_URL/contatos")
+response_code=$(curl -s "${AUTH[@]}" -o /dev/null -w "%{http_code}" "$CONTATOS_URL")
 assert_status 200 "$response_code" "Listar Todos os Contatos"
 
 # 4. Buscar Contato por ID (Sucesso)
 print_test_name "Buscar Contato por ID (Sucesso)"
-response_code=$(curl -s -o /dev/null -w "%{http_code}" "$BASE_URL/contatos/101")
+response_code=$(curl -s "${AUTH[@]}" -o /dev/null -w "%{http_code}" "$CONTATOS_URL/$CONTATO_ID")
 assert_status 200 "$response_code" "Buscar Contato por ID (Sucesso)"
 
 # 5. Buscar Contato por ID (Não Encontrado)
 print_test_name "Buscar Contato por ID (Erro 404 - Não Encontrado)"
-response_code=$(curl -s -o /dev/null -w "%{http_code}" "$BASE_URL/contatos/9999")
+response_code=$(curl -s "${AUTH[@]}" -o /dev/null -w "%{http_code}" "$CONTATOS_URL/9999")
 assert_status 404 "$response_code" "Buscar Contato por ID (Erro 404 - Não Encontrado)"
 
 # 6. Atualizar Contato (Sucesso)
 print_test_name "Atualizar Contato (Sucesso)"
-response_code=$(curl -s -o /dev/null -w "%{http_code}" -X PUT "$BASE_URL/contatos/101" \
+response_code=$(curl -s "${AUTH[@]}" -o /dev/null -w "%{http_code}" -X PUT "$CONTATOS_URL/$CONTATO_ID" \
 -H "Content-Type: application/json" \
 -d '{
     "nome": "Fulano de Tal ATUALIZADO",
     "idade": 26,
-    "telefones": [{"id_contato": 101, "id": 1, "numero": "99999-1111"}]
+    "telefones": [{"id": 1, "numero": "(11) 99999-1111"}]
 }')
 assert_status 200 "$response_code" "Atualizar Contato (Sucesso)"
 
 # 7. Deletar Contato (Sucesso)
 print_test_name "Deletar Contato (Sucesso)"
-response_code=$(curl -s -o /dev/null -w "%{http_code}" -X DELETE "$BASE_URL/contatos/101")
+response_code=$(curl -s "${AUTH[@]}" -o /dev/null -w "%{http_code}" -X DELETE "$CONTATOS_URL/$CONTATO_ID")
 assert_status 204 "$response_code" "Deletar Contato (Sucesso)"
 
 # 8. Verificar se o Contato foi Deletado
 print_test_name "Verificar se o Contato foi Deletado (Erro 404)"
-response_code=$(curl -s -o /dev/null -w "%{http_code}" "$BASE_URL/contatos/101")
+response_code=$(curl -s "${AUTH[@]}" -o /dev/null -w "%{http_code}" "$CONTATOS_URL/$CONTATO_ID")
 assert_status 404 "$response_code" "Verificar se o Contato foi Deletado (Erro 404)"
 
 # 9. Tentar Deletar um Contato Inexistente
 print_test_name "Tentar Deletar Contato Inexistente (Erro 500)"
 # A API retorna 500 porque o repositório avisa que o ID não foi encontrado para deleção
-response_code=$(curl -s -o /dev/null -w "%{http_code}" -X DELETE "$BASE_URL/contatos/9999")
+response_code=$(curl -s "${AUTH[@]}" -o /dev/null -w "%{http_code}" -X DELETE "$CONTATOS_URL/9999")
 assert_status 500 "$response_code" "Tentar Deletar Contato Inexistente (Erro 500)"
 
 # 10. Verificar se o Log de Exclusão foi Criado e Contém o ID
 print_test_name "Verificar Arquivo de Log de Exclusão"
 if [ -f "$LOG_FILE" ]; then
     echo "✅  PASS: Arquivo de log '$LOG_FILE' encontrado."
-    if grep -q "Contato ID 101 excluído" "$LOG_FILE"; then
-        echo "✅  PASS: Log para o contato ID 101 encontrado no arquivo."
+    if grep -q "\"id_contato\":$CONTATO_ID," "$LOG_FILE"; then
+        echo "✅  PASS: Log para o contato ID $CONTATO_ID encontrado no arquivo."
     else
-        echo "❌  FAIL: Log para o contato ID 101 NÃO encontrado no arquivo."
+        echo "❌  FAIL: Log para o contato ID $CONTATO_ID NÃO encontrado no arquivo."
         ((FAIL_COUNT++))
     fi
 else